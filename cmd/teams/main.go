@@ -0,0 +1,71 @@
+// Command teams renames and merges the teams of the registry directly in the
+// database, e.g.
+//
+//	teams rename Loins Lions
+//	teams merge "Lions FC" Lions
+//
+// Teams are found by their name or alias. The database is taken from the
+// DB_URL environment variable.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/slawekzachcial/tournament/internal/db"
+	"github.com/slawekzachcial/tournament/internal/tournament"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage:\n  %[1]s rename <team> <new name>\n  %[1]s merge <team created by mistake> <team>\n", os.Args[0])
+	}
+	flag.Parse()
+	if flag.NArg() != 3 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	dbUrl := os.Getenv("DB_URL")
+	if dbUrl == "" {
+		log.Fatalln("DB_URL environment variable not set")
+	}
+	dbPool, err := pgxpool.Connect(context.Background(), dbUrl)
+	if err != nil {
+		log.Fatalf("Error creating connection pool: %v", err)
+	}
+	defer dbPool.Close()
+
+	teams := db.NewTeamsData(dbPool)
+	registry := tournament.NewTeamRegistry(teams).As(tournament.Actor{Principal: "cli:" + os.Getenv("USER")})
+
+	team, err := teams.FindByName(flag.Arg(1))
+	if err != nil {
+		log.Fatalf("Error finding team '%s': %v", flag.Arg(1), err)
+	}
+
+	var changed *tournament.Team
+	switch flag.Arg(0) {
+	case "rename":
+		changed, err = registry.RenameTeam(team.ID, flag.Arg(2))
+	case "merge":
+		var into *tournament.Team
+		if into, err = teams.FindByName(flag.Arg(2)); err != nil {
+			log.Fatalf("Error finding team '%s': %v", flag.Arg(2), err)
+		}
+		changed, err = registry.MergeTeams(team.ID, into.ID)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatalf("Error changing team: %v", err)
+	}
+
+	fmt.Printf("%d\t%s\t%s\t%s\n", changed.ID, changed.Name, changed.ShortCode, strings.Join(changed.Aliases, ", "))
+}
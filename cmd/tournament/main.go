@@ -2,23 +2,53 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"log"
+	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/slawekzachcial/tournament/internal/db"
 	"github.com/slawekzachcial/tournament/internal/gen/models"
 	"github.com/slawekzachcial/tournament/internal/gen/restapi"
 	"github.com/slawekzachcial/tournament/internal/gen/restapi/operations"
 	"github.com/slawekzachcial/tournament/internal/tournament"
+	"github.com/slawekzachcial/tournament/internal/webhooks"
+	"golang.org/x/net/websocket"
 )
 
 var portFlag = flag.Int("port", 3000, "Port to run this service on")
+var winPointsFlag = flag.Int("win-points", 3, "Points awarded for a win in competitions created without scoring rules")
+var drawPointsFlag = flag.Int("draw-points", 1, "Points awarded for a draw in competitions created without scoring rules")
+var lossPointsFlag = flag.Int("loss-points", 0, "Points awarded for a loss in competitions created without scoring rules")
+var bigWinMarginFlag = flag.Int("big-win-margin", 0, "Goal margin from which a win earns bonus points, 0 disables the bonus, in competitions created without scoring rules")
+var bigWinBonusFlag = flag.Int("big-win-bonus", 0, "Bonus points awarded for a big win in competitions created without scoring rules")
+var forfeitDeductionFlag = flag.Int("forfeit-deduction", 0, "Points taken from a team losing by forfeit in competitions created without scoring rules")
+var overtimeFlag = flag.Bool("overtime", false, "Score games decided in extra time or by penalties with overtime points in competitions created without scoring rules")
+var overtimeWinPointsFlag = flag.Int("overtime-win-points", 2, "Points awarded for a win in extra time or by penalties when overtime scoring is enabled")
+var overtimeLossPointsFlag = flag.Int("overtime-loss-points", 1, "Points awarded for a loss in extra time or by penalties when overtime scoring is enabled")
+var tieBreakersFlag = flag.String("tie-breakers", "points,goal-difference,goals-for,head-to-head,wins,name", "Comma separated criteria used to rank teams")
+var drawSeedFlag = flag.Int64("draw-seed", 0, "Seed of the 'draw' tie-breaker")
+var streamHeartbeatFlag = flag.Duration("stream-heartbeat", 15*time.Second, "Interval of the heartbeats keeping the statistics stream open")
+var webhookIntervalFlag = flag.Duration("webhook-interval", 5*time.Second, "Interval at which the webhook events are delivered")
+var webhookMaxAttemptsFlag = flag.Int("webhook-max-attempts", 8, "Attempts to deliver a webhook event before it is dead")
+var webhookRetryDelayFlag = flag.Duration("webhook-retry-delay", 30*time.Second, "Delay before retrying a failed webhook delivery, doubled after every failed attempt")
+var webhookMaxRetryDelayFlag = flag.Duration("webhook-max-retry-delay", 6*time.Hour, "Longest delay before retrying a failed webhook delivery")
 
 func main() {
 	dbUrl := os.Getenv("DB_URL")
@@ -53,85 +83,1716 @@ func main() {
 	defer dbPool.Close()
 
 	games := db.NewGameData(dbPool)
-	theTournament := tournament.NewTournament(games)
+	fixtures := db.NewFixturesData(dbPool)
+	brackets := db.NewBracketsData(dbPool)
+	groupStages := db.NewGroupStagesData(dbPool)
+	competitions := db.NewCompetitionsData(dbPool)
+	auditLog := db.NewAuditData(dbPool)
+	teams := db.NewTeamsData(dbPool)
+	adjustments := db.NewPointAdjustmentsData(dbPool)
+	webhookData := db.NewWebhooksData(dbPool)
+	bus := tournament.NewEventBus()
+	liveGames := tournament.NewLiveGames()
+	changes, err := db.NewChangeFeed(dbPool).Subscribe(context.Background())
+	if err != nil {
+		log.Fatalf("Error subscribing to changes: %v", err)
+	}
+	go publishRemoteChanges(changes, bus)
+	defaultRules := scoringRulesFromFlags()
+	tieBreakers, err := tournament.ParseTieBreakers(*tieBreakersFlag, *drawSeedFlag)
+	if err != nil {
+		log.Fatalf("Error parsing tie-breakers: %v", err)
+	}
+	organizer := tournament.NewOrganizer(competitions, func(c *tournament.Competition) *tournament.Tournament {
+		return tournament.NewTournament(games.ForCompetition(c.ID),
+			tournament.WithFixtures(fixtures.ForCompetition(c.ID)),
+			tournament.WithBrackets(brackets.ForCompetition(c.ID)),
+			tournament.WithGroupStages(groupStages.ForCompetition(c.ID)),
+			tournament.WithScoringRules(c.Scoring),
+			tournament.WithTieBreakers(tieBreakers...),
+			tournament.WithAuditLog(auditLog.ForCompetition(c.ID)),
+			tournament.WithPointAdjustments(adjustments.ForCompetition(c.ID)),
+			tournament.WithPublisher(bus.ForCompetition(c.ID)),
+			tournament.WithLiveGames(liveGames.ForCompetition(c.ID)),
+			tournament.WithTeamRegistry(teams))
+	})
+	// the default competition, created by the migrations, scores games with
+	// the rules of the flags
+	if err := organizer.UpdateScoring(tournament.DefaultCompetitionID, defaultRules); err != nil {
+		log.Fatalf("Error updating scoring rules of default competition: %v", err)
+	}
+
+	// endpoints outside of /competitions serve the default competition, found
+	// on every request to follow the changes of its season, zones and teams
+	api.PlayHandler = playHandler(organizer, tournament.DefaultCompetitionID)
+	api.ListGamesHandler = listGamesHandler(organizer)
+	api.GetGameHandler = getGameHandler(organizer, tournament.DefaultCompetitionID)
+	api.UpdateGameHandler = updateGameHandler(organizer, tournament.DefaultCompetitionID)
+	api.PatchGameHandler = patchGameHandler(organizer, tournament.DefaultCompetitionID)
+	api.DeleteGameHandler = deleteGameHandler(organizer, tournament.DefaultCompetitionID)
+	api.GetGameHistoryHandler = getGameHistoryHandler(organizer, tournament.DefaultCompetitionID)
+	api.GetAuditTrailHandler = getAuditTrailHandler(organizer)
+	api.GetAllStatsHandler = getAllStatsHandler(organizer, tournament.DefaultCompetitionID)
+	api.GetTeamStatsHandler = getTeamStatsHandler(organizer, tournament.DefaultCompetitionID)
+	api.GetStatsStreamHandler = getStatsStreamHandler(organizer, bus, *streamHeartbeatFlag)
+	api.GetLiveFeedHandler = getLiveFeedHandler(organizer, bus)
+	api.GetHeadToHeadStatsHandler = getHeadToHeadStatsHandler(organizer, tournament.DefaultCompetitionID)
+	api.GetTeamAdjustmentsHandler = getTeamAdjustmentsHandler(organizer, tournament.DefaultCompetitionID)
+	api.AdjustPointsHandler = adjustPointsHandler(organizer, tournament.DefaultCompetitionID)
+	api.GetFixturesHandler = getFixturesHandler(organizer, tournament.DefaultCompetitionID)
+	api.ScheduleFixturesHandler = scheduleFixturesHandler(organizer, tournament.DefaultCompetitionID)
+	api.UpdateFixtureStatusHandler = updateFixtureStatusHandler(organizer, tournament.DefaultCompetitionID)
+	api.RecordFixtureResultHandler = recordFixtureResultHandler(organizer, tournament.DefaultCompetitionID)
+	api.PairSwissRoundHandler = pairSwissRoundHandler(organizer, tournament.DefaultCompetitionID)
+	api.CreateBracketHandler = createBracketHandler(organizer, tournament.DefaultCompetitionID)
+	api.GetBracketHandler = getBracketHandler(organizer, tournament.DefaultCompetitionID)
+	api.CreateGroupStageHandler = createGroupStageHandler(organizer, tournament.DefaultCompetitionID)
+	api.GetGroupStageHandler = getGroupStageHandler(organizer, tournament.DefaultCompetitionID)
+	api.ListCompetitionsHandler = listCompetitionsHandler(organizer)
+	api.CreateCompetitionHandler = createCompetitionHandler(organizer, defaultRules)
+	api.GetCompetitionHandler = getCompetitionHandler(organizer)
+	api.PlayInCompetitionHandler = playInCompetitionHandler(organizer)
+	api.GetCompetitionStatsHandler = getCompetitionStatsHandler(organizer)
+	api.GetCompetitionTeamStatsHandler = getCompetitionTeamStatsHandler(organizer)
+	api.GetCompetitionFixturesHandler = getCompetitionFixturesHandler(organizer)
+	api.CloseSeasonHandler = closeSeasonHandler(organizer)
+	api.RolloverSeasonHandler = rolloverSeasonHandler(organizer)
+	api.GetCompetitionMovementsHandler = getCompetitionMovementsHandler(organizer)
+	registry := tournament.NewTeamRegistry(teams)
+	api.ListTeamsHandler = listTeamsHandler(registry)
+	api.CreateTeamHandler = createTeamHandler(registry)
+	api.GetTeamHandler = getTeamHandler(registry)
+	api.UpdateTeamHandler = updateTeamHandler(registry)
+	api.DeleteTeamHandler = deleteTeamHandler(registry)
+	api.RenameTeamHandler = renameTeamHandler(registry)
+	api.MergeTeamsHandler = mergeTeamsHandler(registry)
+	api.GetTeamHistoryHandler = getTeamHistoryHandler(registry)
+	webhookRegistry := tournament.NewWebhookRegistry(webhookData)
+	api.ListWebhooksHandler = listWebhooksHandler(webhookRegistry)
+	api.CreateWebhookHandler = createWebhookHandler(webhookRegistry)
+	api.GetWebhookHandler = getWebhookHandler(webhookRegistry)
+	api.UpdateWebhookHandler = updateWebhookHandler(webhookRegistry)
+	api.DeleteWebhookHandler = deleteWebhookHandler(webhookRegistry)
+	api.GetWebhookDeliveriesHandler = getWebhookDeliveriesHandler(webhookRegistry)
+	api.RetryWebhookDeliveryHandler = retryWebhookDeliveryHandler(webhookRegistry)
 
-	api.PlayHandler = playHandler(theTournament)
-	api.GetAllStatsHandler = getAllStatsHandler(theTournament)
-	api.GetTeamStatsHandler = getTeamStatsHandler(theTournament)
+	dispatcher := webhooks.NewDispatcher(webhookData, func(competitionID int) (string, error) {
+		return standingsLeader(organizer, competitionID)
+	})
+	dispatcher.MaxAttempts = *webhookMaxAttemptsFlag
+	dispatcher.BaseDelay = *webhookRetryDelayFlag
+	dispatcher.MaxDelay = *webhookMaxRetryDelayFlag
+	go dispatcher.Run(context.Background(), *webhookIntervalFlag)
 
 	api.KeyAuth = keyAuth
+	api.TextEventStreamProducer = runtime.TextProducer()
 
 	if err := server.Serve(); err != nil {
 		log.Fatalf("Error starting server: %v", err)
 	}
 }
 
-func playHandler(theTournament *tournament.Tournament) operations.PlayHandlerFunc {
+// scoringRulesFromFlags returns the scoring rules of the default competition
+// and of the competitions created without their own.
+func scoringRulesFromFlags() tournament.ScoringRules {
+	return tournament.ScoringRules{
+		Win:              *winPointsFlag,
+		Draw:             *drawPointsFlag,
+		Loss:             *lossPointsFlag,
+		BigWinMargin:     *bigWinMarginFlag,
+		BigWinBonus:      *bigWinBonusFlag,
+		ForfeitDeduction: *forfeitDeductionFlag,
+		Overtime:         *overtimeFlag,
+		OvertimeWin:      *overtimeWinPointsFlag,
+		OvertimeLoss:     *overtimeLossPointsFlag,
+	}
+}
+
+func playHandler(organizer *tournament.Organizer, competitionID int) operations.PlayHandlerFunc {
 	return func(params operations.PlayParams, principal *models.Principal) middleware.Responder {
-		game := tournament.Game{*params.Body.TeamA, int(*params.Body.ScoreA), *params.Body.TeamB, int(*params.Body.ScoreB)}
-		err := theTournament.Play(game)
+		theTournament, err := organizer.Tournament(competitionID)
+		if err != nil {
+			return competitionError(int64(competitionID), err)
+		}
+		game, err := gameFromModel(params.Body)
+		if err != nil {
+			msg := err.Error()
+			return operations.NewPlayDefault(400).WithPayload(&models.Error{Code: 400, Message: &msg})
+		}
+		played, err := theTournament.As(actor(params.HTTPRequest, principal)).Play(*game)
+		if err != nil {
+			payload := errorToModel(err)
+			return operations.NewPlayDefault(int(payload.Code)).WithPayload(payload)
+		}
+
+		return operations.NewPlayCreated().WithPayload(gameToModel(played))
+	}
+}
+
+func listGamesHandler(organizer *tournament.Organizer) operations.ListGamesHandlerFunc {
+	return func(params operations.ListGamesParams) middleware.Responder {
+		competitionID := int64(tournament.DefaultCompetitionID)
+		if params.Competition != nil {
+			competitionID = *params.Competition
+		}
+		theTournament, err := organizer.Tournament(int(competitionID))
+		if err != nil {
+			return competitionError(competitionID, err)
+		}
+
+		filter := tournament.GameFilter{
+			Team:       swag.StringValue(params.Team),
+			Opponent:   swag.StringValue(params.Opponent),
+			Result:     tournament.GameResult(swag.StringValue(params.Result)),
+			SortBy:     tournament.GameSort(swag.StringValue(params.Sort)),
+			Descending: swag.StringValue(params.Order) == "desc",
+			Limit:      int(swag.Int64Value(params.Limit)),
+		}
+		if params.From != nil {
+			filter.From = time.Time(*params.From)
+		}
+		if params.To != nil {
+			filter.To = time.Time(*params.To)
+		}
+		if params.Cursor != nil {
+			after, err := tournament.ParseGameCursor(*params.Cursor)
+			if err != nil {
+				msg := err.Error()
+				return operations.NewListGamesDefault(400).WithPayload(&models.Error{Code: 400, Message: &msg})
+			}
+			filter.After = after
+		}
+
+		page, err := theTournament.ListGames(filter)
+		if err != nil {
+			code := 500
+			if err == tournament.ErrInvalidGameFilter {
+				code = 400
+			}
+			msg := err.Error()
+			return operations.NewListGamesDefault(code).WithPayload(&models.Error{Code: int64(code), Message: &msg})
+		}
+
+		payload := &models.GamePage{Games: make([]*models.Game, 0, len(page.Games)), NextCursor: page.NextCursor}
+		for i := range page.Games {
+			payload.Games = append(payload.Games, gameToModel(&page.Games[i]))
+		}
+		return operations.NewListGamesOK().WithPayload(payload)
+	}
+}
+
+func getGameHandler(organizer *tournament.Organizer, competitionID int) operations.GetGameHandlerFunc {
+	return func(params operations.GetGameParams) middleware.Responder {
+		theTournament, err := organizer.Tournament(competitionID)
+		if err != nil {
+			return competitionError(int64(competitionID), err)
+		}
+		game, err := theTournament.GetGame(int(params.ID))
+		if err != nil {
+			if err == tournament.ErrGameNotFound {
+				msg := fmt.Sprintf("Game '%d' not found", params.ID)
+				return operations.NewGetGameDefault(404).WithPayload(&models.Error{Code: 404, Message: &msg})
+			} else {
+				msg := err.Error()
+				return operations.NewGetGameDefault(500).WithPayload(&models.Error{Code: 500, Message: &msg})
+			}
+		}
+
+		return operations.NewGetGameOK().WithPayload(gameToModel(game))
+	}
+}
+
+func updateGameHandler(organizer *tournament.Organizer, competitionID int) operations.UpdateGameHandlerFunc {
+	return func(params operations.UpdateGameParams, principal *models.Principal) middleware.Responder {
+		theTournament, err := organizer.Tournament(competitionID)
+		if err != nil {
+			return competitionError(int64(competitionID), err)
+		}
+		game, err := gameFromModel(params.Body)
+		if err != nil {
+			msg := err.Error()
+			return operations.NewUpdateGameDefault(400).WithPayload(&models.Error{Code: 400, Message: &msg})
+		}
+		game.ID = int(params.ID)
+
+		updated, err := theTournament.As(actor(params.HTTPRequest, principal)).UpdateGame(*game)
+		if err != nil {
+			payload := errorToModel(err)
+			return operations.NewUpdateGameDefault(int(payload.Code)).WithPayload(payload)
+		}
+
+		return operations.NewUpdateGameOK().WithPayload(gameToModel(updated))
+	}
+}
+
+func patchGameHandler(organizer *tournament.Organizer, competitionID int) operations.PatchGameHandlerFunc {
+	return func(params operations.PatchGameParams, principal *models.Principal) middleware.Responder {
+		theTournament, err := organizer.Tournament(competitionID)
+		if err != nil {
+			return competitionError(int64(competitionID), err)
+		}
+		game, err := theTournament.GetGame(int(params.ID))
+		if err == nil {
+			err = patchGame(game, params.Body)
+		}
+		if err == nil {
+			game, err = theTournament.As(actor(params.HTTPRequest, principal)).UpdateGame(*game)
+		}
+		if err != nil {
+			payload := errorToModel(err)
+			return operations.NewPatchGameDefault(int(payload.Code)).WithPayload(payload)
+		}
+
+		return operations.NewPatchGameOK().WithPayload(gameToModel(game))
+	}
+}
+
+func deleteGameHandler(organizer *tournament.Organizer, competitionID int) operations.DeleteGameHandlerFunc {
+	return func(params operations.DeleteGameParams, principal *models.Principal) middleware.Responder {
+		theTournament, err := organizer.Tournament(competitionID)
+		if err != nil {
+			return competitionError(int64(competitionID), err)
+		}
+		if err := theTournament.As(actor(params.HTTPRequest, principal)).DeleteGame(int(params.ID)); err != nil {
+			payload := errorToModel(err)
+			return operations.NewDeleteGameDefault(int(payload.Code)).WithPayload(payload)
+		}
+
+		return operations.NewDeleteGameNoContent()
+	}
+}
+
+func getGameHistoryHandler(organizer *tournament.Organizer, competitionID int) operations.GetGameHistoryHandlerFunc {
+	return func(params operations.GetGameHistoryParams) middleware.Responder {
+		theTournament, err := organizer.Tournament(competitionID)
+		if err != nil {
+			return competitionError(int64(competitionID), err)
+		}
+		history, err := theTournament.GameHistory(int(params.ID))
+		if err != nil {
+			if err == tournament.ErrGameNotFound {
+				msg := fmt.Sprintf("Game '%d' not found", params.ID)
+				return operations.NewGetGameHistoryDefault(404).WithPayload(&models.Error{Code: 404, Message: &msg})
+			} else {
+				msg := err.Error()
+				return operations.NewGetGameHistoryDefault(500).WithPayload(&models.Error{Code: 500, Message: &msg})
+			}
+		}
+
+		return operations.NewGetGameHistoryOK().WithPayload(auditEntriesToModel(history))
+	}
+}
+
+func getAuditTrailHandler(organizer *tournament.Organizer) operations.GetAuditTrailHandlerFunc {
+	return func(params operations.GetAuditTrailParams) middleware.Responder {
+		competitionID := int64(tournament.DefaultCompetitionID)
+		if params.Competition != nil {
+			competitionID = *params.Competition
+		}
+		theTournament, err := organizer.Tournament(int(competitionID))
+		if err != nil {
+			return competitionError(competitionID, err)
+		}
+
+		entries, err := theTournament.AuditTrail(tournament.AuditFilter{
+			Principal: swag.StringValue(params.Principal),
+			BeforeID:  int(swag.Int64Value(params.Before)),
+			Limit:     int(swag.Int64Value(params.Limit)),
+		})
 		if err != nil {
 			msg := err.Error()
-			return operations.NewPlayDefault(400).WithPayload(&models.Error{400, &msg})
+			return operations.NewGetAuditTrailDefault(500).WithPayload(&models.Error{Code: 500, Message: &msg})
+		}
+
+		return operations.NewGetAuditTrailOK().WithPayload(auditEntriesToModel(entries))
+	}
+}
+
+func auditEntriesToModel(entries []tournament.AuditEntry) []*models.AuditEntry {
+	payload := make([]*models.AuditEntry, 0, len(entries))
+	for _, e := range entries {
+		at := strfmt.DateTime(e.At)
+		entry := &models.AuditEntry{
+			ID:        swag.Int64(int64(e.ID)),
+			GameID:    swag.Int64(int64(e.GameID)),
+			Action:    swag.String(string(e.Action)),
+			Principal: swag.String(e.Principal),
+			RequestID: swag.String(e.RequestID),
+			At:        &at,
+		}
+		if e.Before != nil {
+			entry.Before = gameToModel(e.Before)
+		}
+		if e.After != nil {
+			entry.After = gameToModel(e.After)
+		}
+		payload = append(payload, entry)
+	}
+	return payload
+}
+
+// actor identifies who makes the request. The request ID is taken from the
+// X-Request-ID header, or generated when the client did not send one.
+func actor(r *http.Request, principal *models.Principal) tournament.Actor {
+	requestID := r.Header.Get("X-Request-ID")
+	if requestID == "" {
+		b := make([]byte, 16)
+		rand.Read(b)
+		requestID = hex.EncodeToString(b)
+	}
+	return tournament.Actor{Principal: string(*principal), RequestID: requestID}
+}
+
+func errorToModel(err error) *models.Error {
+	msg := err.Error()
+	payload := &models.Error{Code: int64(errorCode(err)), Message: &msg}
+	if verr, ok := err.(*tournament.ValidationError); ok {
+		for _, fe := range verr.Errors {
+			payload.Fields = append(payload.Fields, &models.FieldError{Field: swag.String(fe.Field), Message: swag.String(fe.Message)})
+		}
+	}
+	return payload
+}
+
+// errorCode returns the HTTP status of the domain errors, and 500 for any
+// other error, e.g. of the database.
+func errorCode(err error) int {
+	switch err.(type) {
+	case *tournament.ValidationError:
+		return 422
+	case *tournament.UnknownNameError:
+		return 400
+	}
+	switch err {
+	case tournament.ErrGameNotFound, tournament.ErrTeamNotFound, tournament.ErrFixtureNotFound, tournament.ErrLiveGameNotFound,
+		tournament.ErrWebhookNotFound, tournament.ErrDeliveryNotFound, tournament.ErrCompetitionNotFound, tournament.ErrBracketNotFound,
+		tournament.ErrGroupStageNotFound:
+		return 404
+	case tournament.ErrSeasonClosed, tournament.ErrKnockoutGameDecided, tournament.ErrTeamExists, tournament.ErrTeamInUse,
+		tournament.ErrTeamsPlayedEachOther, tournament.ErrFixtureCancelled, tournament.ErrDeliveryNotDead:
+		return 409
+	case tournament.ErrUndecidedKnockoutGame, tournament.ErrFixturesExist, tournament.ErrNotEnoughTeams, tournament.ErrDuplicateTeam,
+		tournament.ErrEmptyTeam, tournament.ErrByeFixture, tournament.ErrResultRequired, tournament.ErrInvalidGameFilter,
+		tournament.ErrInvalidCursor, tournament.ErrMergeSameTeam, tournament.ErrNotEnoughQualifiers, tournament.ErrGroupTooSmall,
+		tournament.ErrRoundNotComplete, tournament.ErrNoPairings, tournament.ErrInvalidZones, tournament.ErrNotInPlayoffZone,
+		tournament.ErrSeasonNotClosed, tournament.ErrNoDivisions, tournament.ErrInvalidPromotions, tournament.ErrUnknownFormat:
+		return 400
+	default:
+		return 500
+	}
+}
+
+func gameFromModel(m *models.Game) (*tournament.Game, error) {
+	game := &tournament.Game{
+		TeamA:      *m.TeamA,
+		ScoreA:     int(*m.ScoreA),
+		TeamB:      *m.TeamB,
+		ScoreB:     int(*m.ScoreB),
+		PenaltiesA: int(swag.Int64Value(m.PenaltiesA)),
+		PenaltiesB: int(swag.Int64Value(m.PenaltiesB)),
+		PlayedAt:   time.Time(m.PlayedAt),
+	}
+	if m.DecidedIn != nil {
+		period, err := tournament.ParsePeriod(*m.DecidedIn)
+		if err != nil {
+			return nil, err
+		}
+		game.DecidedIn = period
+	}
+	if m.Outcome != nil {
+		outcome, err := tournament.ParseOutcome(*m.Outcome)
+		if err != nil {
+			return nil, err
+		}
+		game.Outcome = outcome
+	}
+	return game, nil
+}
+
+func patchGame(game *tournament.Game, patch *models.GamePatch) error {
+	if patch.TeamA != nil {
+		game.TeamA = *patch.TeamA
+	}
+	if patch.ScoreA != nil {
+		game.ScoreA = int(*patch.ScoreA)
+	}
+	if patch.TeamB != nil {
+		game.TeamB = *patch.TeamB
+	}
+	if patch.ScoreB != nil {
+		game.ScoreB = int(*patch.ScoreB)
+	}
+	if patch.DecidedIn != nil {
+		period, err := tournament.ParsePeriod(*patch.DecidedIn)
+		if err != nil {
+			return err
+		}
+		game.DecidedIn = period
+	}
+	if patch.PenaltiesA != nil {
+		game.PenaltiesA = int(*patch.PenaltiesA)
+	}
+	if patch.PenaltiesB != nil {
+		game.PenaltiesB = int(*patch.PenaltiesB)
+	}
+	if patch.Outcome != nil {
+		outcome, err := tournament.ParseOutcome(*patch.Outcome)
+		if err != nil {
+			return err
 		}
+		game.Outcome = outcome
+	}
+	if patch.PlayedAt != nil {
+		game.PlayedAt = time.Time(*patch.PlayedAt)
+	}
+	return nil
+}
 
-		return operations.NewPlayCreated()
+func gameToModel(game *tournament.Game) *models.Game {
+	return &models.Game{
+		ID:         int64(game.ID),
+		TeamA:      swag.String(game.TeamA),
+		ScoreA:     swag.Int64(int64(game.ScoreA)),
+		TeamB:      swag.String(game.TeamB),
+		ScoreB:     swag.Int64(int64(game.ScoreB)),
+		DecidedIn:  swag.String(game.DecidedIn.String()),
+		PenaltiesA: swag.Int64(int64(game.PenaltiesA)),
+		PenaltiesB: swag.Int64(int64(game.PenaltiesB)),
+		Outcome:    swag.String(game.Outcome.String()),
+		PlayedAt:   strfmt.DateTime(game.PlayedAt),
+		RecordedAt: strfmt.DateTime(game.RecordedAt),
 	}
 }
 
-func getAllStatsHandler(theTournament *tournament.Tournament) operations.GetAllStatsHandlerFunc {
+func getAllStatsHandler(organizer *tournament.Organizer, competitionID int) operations.GetAllStatsHandlerFunc {
 	return func(params operations.GetAllStatsParams) middleware.Responder {
+		theTournament, err := organizer.Tournament(competitionID)
+		if err != nil {
+			return competitionError(int64(competitionID), err)
+		}
 		stats, err := theTournament.GetAllStats()
 		if err != nil {
 			msg := err.Error()
-			return operations.NewGetAllStatsDefault(500).WithPayload(&models.Error{500, &msg})
+			return operations.NewGetAllStatsDefault(500).WithPayload(&models.Error{Code: 500, Message: &msg})
 		}
 
 		payload := make([]*models.Stats, 0, len(stats))
 		for _, s := range stats {
-			played, won, drawn, lost, points := int64(s.Played), int64(s.Won), int64(s.Drawn), int64(s.Lost), int64(s.Points)
-			ms := models.Stats{
-				Team:   &s.Team,
-				Played: &played,
-				Won:    &won,
-				Drawn:  &drawn,
-				Lost:   &lost,
-				Points: &points,
-			}
-			payload = append(payload, &ms)
+			payload = append(payload, statsToModel(s))
 		}
 		return operations.NewGetAllStatsOK().WithPayload(payload)
 	}
 }
 
-func getTeamStatsHandler(theTournament *tournament.Tournament) operations.GetTeamStatsHandlerFunc {
+// getStatsStreamHandler sends all teams statistics as Server-Sent Events with
+// the ID of the latest event, on connection and whenever they change. A client
+// resuming with the ID of the latest event gets the changes only.
+func getStatsStreamHandler(organizer *tournament.Organizer, bus *tournament.EventBus, heartbeat time.Duration) operations.GetStatsStreamHandlerFunc {
+	return func(params operations.GetStatsStreamParams) middleware.Responder {
+		return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+			flusher, ok := rw.(http.Flusher)
+			if !ok {
+				http.Error(rw, "Streaming not supported", http.StatusInternalServerError)
+				return
+			}
+
+			events, cancel := bus.Subscribe()
+			defer cancel()
+
+			rw.Header().Set("Content-Type", "text/event-stream")
+			rw.Header().Set("Cache-Control", "no-cache")
+			rw.WriteHeader(http.StatusOK)
+
+			// the IDs start over when the service restarts, so any other ID
+			// gets the statistics too
+			lastID := bus.LastID()
+			if params.LastEventID == nil || *params.LastEventID != strconv.FormatInt(lastID, 10) {
+				if err := writeStatsEvent(rw, organizer, lastID); err != nil {
+					return
+				}
+			}
+			flusher.Flush()
+
+			ticker := time.NewTicker(heartbeat)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-params.HTTPRequest.Context().Done():
+					return
+				case <-ticker.C:
+					if _, err := io.WriteString(rw, ": heartbeat\n\n"); err != nil {
+						return
+					}
+				case event := <-events:
+					changed := changesStats(event)
+					// a burst of events sends the statistics once
+					for pending := len(events); pending > 0; pending-- {
+						event = <-events
+						changed = changed || changesStats(event)
+					}
+					if !changed {
+						continue
+					}
+					if err := writeStatsEvent(rw, organizer, event.ID); err != nil {
+						return
+					}
+				}
+				flusher.Flush()
+			}
+		})
+	}
+}
+
+// publishRemoteChanges publishes the changes of the games, fixtures and point
+// adjustments made by the other replicas on the event bus, so that the streams
+// of this replica tell about them too.
+func publishRemoteChanges(changes <-chan db.Change, bus *tournament.EventBus) {
+	for change := range changes {
+		if change.Local || change.Event.Type == tournament.LeaderChanged {
+			continue
+		}
+		bus.ForCompetition(change.Event.CompetitionID).Publish(tournament.Event{
+			Type:       change.Event.Type,
+			Game:       change.Event.Game,
+			Fixture:    change.Event.Fixture,
+			Adjustment: change.Event.Adjustment,
+			At:         change.Event.At,
+		})
+	}
+}
+
+// changesStats tells whether the event changes the statistics of the default
+// competition. The games being played do not count until they end.
+func changesStats(event tournament.Event) bool {
+	return event.CompetitionID == tournament.DefaultCompetitionID && event.LiveGame == nil
+}
+
+// writeStatsEvent sends the statistics of the default competition as they are
+// now.
+func writeStatsEvent(w io.Writer, organizer *tournament.Organizer, id int64) error {
+	var stats []tournament.Stats
+	theTournament, err := organizer.Tournament(tournament.DefaultCompetitionID)
+	if err == nil {
+		stats, err = theTournament.GetAllStats()
+	}
+	if err != nil {
+		log.Printf("Error getting stats to stream: %v", err)
+		return err
+	}
+
+	payload := make([]*models.Stats, 0, len(stats))
+	for _, s := range stats {
+		payload = append(payload, statsToModel(s))
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: stats\ndata: %s\n\n", id, data)
+	return err
+}
+
+// liveMessageTypes are the types of the live feed messages sent on events.
+var liveMessageTypes = map[tournament.EventType]string{
+	tournament.LiveGameStarted:   "started",
+	tournament.LiveScoreUpdated:  "updated",
+	tournament.LiveGameEnded:     "ended",
+	tournament.LiveGameCancelled: "cancelled",
+}
+
+// getLiveFeedHandler serves the WebSocket feed of the games being played.
+// Every subscriber gets a snapshot of the live games on connection, then the
+// changes of the live games and the results, with the provisional standings.
+// Scorekeepers authenticated with x-token start the games, update their
+// scores and end them, which plays them in the tournament.
+func getLiveFeedHandler(organizer *tournament.Organizer, bus *tournament.EventBus) operations.GetLiveFeedHandlerFunc {
+	return func(params operations.GetLiveFeedParams) middleware.Responder {
+		var principal *models.Principal
+		if params.XToken != nil {
+			var err error
+			if principal, err = keyAuth(*params.XToken); err != nil {
+				msg := err.Error()
+				return operations.NewGetLiveFeedDefault(401).WithPayload(&models.Error{Code: 401, Message: &msg})
+			}
+		}
+
+		return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+			feed := &liveFeed{organizer: organizer}
+			if principal != nil {
+				scorekeeper := actor(params.HTTPRequest, principal)
+				feed.scorekeeper = &scorekeeper
+			}
+			// the feed is open to other origins like the REST API
+			server := websocket.Server{Handler: func(ws *websocket.Conn) {
+				feed.ws = ws
+				feed.serve(bus)
+			}}
+			server.ServeHTTP(rw, params.HTTPRequest)
+		})
+	}
+}
+
+type liveFeed struct {
+	ws        *websocket.Conn
+	organizer *tournament.Organizer
+	// scorekeeper is set for the feeds authenticated with x-token
+	scorekeeper *tournament.Actor
+	// mu serializes the messages sent on events and the command errors
+	mu sync.Mutex
+}
+
+func (f *liveFeed) serve(bus *tournament.EventBus) {
+	events, cancel := bus.Subscribe()
+	defer cancel()
+
+	theTournament, err := f.organizer.Tournament(tournament.DefaultCompetitionID)
+	if err != nil {
+		f.sendError(err)
+		return
+	}
+	liveGames, err := theTournament.GetLiveGames()
+	if err != nil {
+		f.sendError(err)
+		return
+	}
+	snapshot := f.message("snapshot")
+	snapshot.LiveGames = make([]*models.LiveGame, 0, len(liveGames))
+	for i := range liveGames {
+		snapshot.LiveGames = append(snapshot.LiveGames, liveGameToModel(&liveGames[i]))
+	}
+	if err := f.send(snapshot); err != nil {
+		return
+	}
+
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			var command models.LiveCommand
+			if err := websocket.JSON.Receive(f.ws, &command); err != nil {
+				return
+			}
+			if err := f.execute(&command); err != nil {
+				f.sendError(err)
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-closed:
+			return
+		case event := <-events:
+			if event.CompetitionID != tournament.DefaultCompetitionID {
+				continue
+			}
+			messageType, ok := liveMessageTypes[event.Type]
+			if !ok {
+				messageType = "standings"
+			}
+			message := f.message(messageType)
+			if event.LiveGame != nil {
+				message.LiveGame = liveGameToModel(event.LiveGame)
+			}
+			if event.Type == tournament.LiveGameEnded {
+				message.Result = gameToModel(event.Game)
+			}
+			if err := f.send(message); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// execute applies the command of a scorekeeper. The changes reach every
+// subscriber through the events they publish.
+func (f *liveFeed) execute(command *models.LiveCommand) error {
+	if f.scorekeeper == nil {
+		return errors.New(401, "Scorekeepers must authenticate with x-token")
+	}
+	if err := command.Validate(strfmt.Default); err != nil {
+		return err
+	}
+	theTournament, err := f.organizer.Tournament(tournament.DefaultCompetitionID)
+	if err != nil {
+		return err
+	}
+	scorekeeper := theTournament.As(*f.scorekeeper)
+
+	var game *tournament.Game
+	if command.Game != nil {
+		if game, err = gameFromModel(command.Game); err != nil {
+			return err
+		}
+	}
+
+	switch *command.Action {
+	case "start":
+		if game == nil {
+			return errors.Required("game", "body", nil)
+		}
+		_, err := scorekeeper.StartLiveGame(*game)
+		return err
+	case "score":
+		if game == nil {
+			return errors.Required("game", "body", nil)
+		}
+		_, err := scorekeeper.UpdateLiveGame(int(command.ID), *game)
+		return err
+	case "end":
+		_, err := scorekeeper.EndLiveGame(int(command.ID))
+		return err
+	default:
+		return scorekeeper.CancelLiveGame(int(command.ID))
+	}
+}
+
+// message returns the message of the type with the provisional standings.
+func (f *liveFeed) message(messageType string) *models.LiveMessage {
+	message := &models.LiveMessage{Type: swag.String(messageType)}
+	var standings []tournament.Stats
+	theTournament, err := f.organizer.Tournament(tournament.DefaultCompetitionID)
+	if err == nil {
+		standings, err = theTournament.LiveStandings()
+	}
+	if err != nil {
+		log.Printf("Error getting live standings: %v", err)
+		return message
+	}
+	message.Standings = make([]*models.Stats, 0, len(standings))
+	for _, s := range standings {
+		message.Standings = append(message.Standings, statsToModel(s))
+	}
+	return message
+}
+
+func (f *liveFeed) sendError(err error) {
+	payload := errorToModel(err)
+	if apiErr, ok := err.(errors.Error); ok {
+		payload.Code = int64(apiErr.Code())
+	}
+	f.send(&models.LiveMessage{Type: swag.String("error"), Error: payload})
+}
+
+func (f *liveFeed) send(message *models.LiveMessage) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return websocket.JSON.Send(f.ws, message)
+}
+
+func liveGameToModel(live *tournament.LiveGame) *models.LiveGame {
+	return &models.LiveGame{
+		ID:        swag.Int64(int64(live.ID)),
+		Game:      gameToModel(&live.Game),
+		StartedAt: strfmt.DateTime(live.StartedAt),
+		UpdatedAt: strfmt.DateTime(live.UpdatedAt),
+	}
+}
+
+func getTeamStatsHandler(organizer *tournament.Organizer, competitionID int) operations.GetTeamStatsHandlerFunc {
 	return func(params operations.GetTeamStatsParams) middleware.Responder {
+		theTournament, err := organizer.Tournament(competitionID)
+		if err != nil {
+			return competitionError(int64(competitionID), err)
+		}
 		s, err := theTournament.GetStats(params.Team)
 		if err != nil {
 			if err == tournament.ErrTeamNotFound {
 				msg := fmt.Sprintf("Team '%s' not found", params.Team)
-				return operations.NewGetTeamStatsDefault(404).WithPayload(&models.Error{404, &msg})
+				return operations.NewGetTeamStatsDefault(404).WithPayload(&models.Error{Code: 404, Message: &msg})
+			} else {
+				msg := err.Error()
+				return operations.NewGetTeamStatsDefault(500).WithPayload(&models.Error{Code: 500, Message: &msg})
+			}
+		}
+		return operations.NewGetTeamStatsOK().WithPayload(statsToModel(s))
+	}
+}
+
+func getHeadToHeadStatsHandler(organizer *tournament.Organizer, competitionID int) operations.GetHeadToHeadStatsHandlerFunc {
+	return func(params operations.GetHeadToHeadStatsParams) middleware.Responder {
+		theTournament, err := organizer.Tournament(competitionID)
+		if err != nil {
+			return competitionError(int64(competitionID), err)
+		}
+		stats, err := theTournament.HeadToHead(params.Teams)
+		if err != nil {
+			if err == tournament.ErrTeamNotFound {
+				msg := fmt.Sprintf("Teams '%s' not found", strings.Join(params.Teams, ","))
+				return operations.NewGetHeadToHeadStatsDefault(404).WithPayload(&models.Error{Code: 404, Message: &msg})
 			} else {
 				msg := err.Error()
-				return operations.NewGetTeamStatsDefault(500).WithPayload(&models.Error{500, &msg})
+				return operations.NewGetHeadToHeadStatsDefault(500).WithPayload(&models.Error{Code: 500, Message: &msg})
 			}
 		}
-		played, won, drawn, lost, points := int64(s.Played), int64(s.Won), int64(s.Drawn), int64(s.Lost), int64(s.Points)
-		ms := models.Stats{
-			Team:   &s.Team,
-			Played: &played,
-			Won:    &won,
-			Drawn:  &drawn,
-			Lost:   &lost,
-			Points: &points,
+
+		payload := make([]*models.Stats, 0, len(stats))
+		for _, s := range stats {
+			payload = append(payload, statsToModel(s))
+		}
+		return operations.NewGetHeadToHeadStatsOK().WithPayload(payload)
+	}
+}
+
+func statsToModel(s tournament.Stats) *models.Stats {
+	return &models.Stats{
+		Team:                swag.String(s.Team),
+		Played:              swag.Int64(int64(s.Played)),
+		Won:                 swag.Int64(int64(s.Won)),
+		Drawn:               swag.Int64(int64(s.Drawn)),
+		Lost:                swag.Int64(int64(s.Lost)),
+		GoalsFor:            swag.Int64(int64(s.GoalsFor)),
+		GoalsAgainst:        swag.Int64(int64(s.GoalsAgainst)),
+		GoalDifference:      swag.Int64(int64(s.GoalDifference)),
+		Points:              swag.Int64(int64(s.Points)),
+		BasePoints:          int64(s.Points - s.Adjustment),
+		Adjustment:          int64(s.Adjustment),
+		AwardedGoalsFor:     int64(s.AwardedGoalsFor),
+		AwardedGoalsAgainst: int64(s.AwardedGoalsAgainst),
+		RegulationWon:       int64(s.RegulationWon),
+		OvertimeWon:         int64(s.OvertimeWon),
+		OvertimeLost:        int64(s.OvertimeLost),
+		Zone:                string(s.Zone),
+	}
+}
+
+func getTeamAdjustmentsHandler(organizer *tournament.Organizer, competitionID int) operations.GetTeamAdjustmentsHandlerFunc {
+	return func(params operations.GetTeamAdjustmentsParams) middleware.Responder {
+		theTournament, err := organizer.Tournament(competitionID)
+		if err != nil {
+			return competitionError(int64(competitionID), err)
+		}
+		adjustments, err := theTournament.GetAdjustments(params.Team)
+		if err != nil {
+			payload := errorToModel(err)
+			return operations.NewGetTeamAdjustmentsDefault(int(payload.Code)).WithPayload(payload)
+		}
+
+		payload := make([]*models.PointAdjustment, 0, len(adjustments))
+		for i := range adjustments {
+			payload = append(payload, adjustmentToModel(&adjustments[i]))
 		}
-		return operations.NewGetTeamStatsOK().WithPayload(&ms)
+		return operations.NewGetTeamAdjustmentsOK().WithPayload(payload)
 	}
 }
 
+func adjustPointsHandler(organizer *tournament.Organizer, competitionID int) operations.AdjustPointsHandlerFunc {
+	return func(params operations.AdjustPointsParams, principal *models.Principal) middleware.Responder {
+		theTournament, err := organizer.Tournament(competitionID)
+		if err != nil {
+			return competitionError(int64(competitionID), err)
+		}
+		adjustment := tournament.PointAdjustment{
+			Team:   params.Team,
+			Delta:  int(*params.Body.Delta),
+			Reason: *params.Body.Reason,
+		}
+		adjusted, err := theTournament.As(actor(params.HTTPRequest, principal)).AdjustPoints(adjustment)
+		if err != nil {
+			payload := errorToModel(err)
+			return operations.NewAdjustPointsDefault(int(payload.Code)).WithPayload(payload)
+		}
+		return operations.NewAdjustPointsCreated().WithPayload(adjustmentToModel(adjusted))
+	}
+}
+
+func adjustmentToModel(adjustment *tournament.PointAdjustment) *models.PointAdjustment {
+	return &models.PointAdjustment{
+		ID:        int64(adjustment.ID),
+		Team:      adjustment.Team,
+		Delta:     swag.Int64(int64(adjustment.Delta)),
+		Reason:    swag.String(adjustment.Reason),
+		Principal: adjustment.Principal,
+		RequestID: adjustment.RequestID,
+		At:        strfmt.DateTime(adjustment.At),
+	}
+}
+
+func getFixturesHandler(organizer *tournament.Organizer, competitionID int) operations.GetFixturesHandlerFunc {
+	return func(params operations.GetFixturesParams) middleware.Responder {
+		theTournament, err := organizer.Tournament(competitionID)
+		if err != nil {
+			return competitionError(int64(competitionID), err)
+		}
+		var fixtures []tournament.Fixture
+		if params.Status != nil {
+			var status tournament.FixtureStatus
+			if status, err = tournament.ParseFixtureStatus(*params.Status); err != nil {
+				payload := errorToModel(err)
+				return operations.NewGetFixturesDefault(int(payload.Code)).WithPayload(payload)
+			}
+			fixtures, err = theTournament.GetFixturesByStatus(status)
+		} else {
+			fixtures, err = theTournament.GetFixtures()
+		}
+		if err != nil {
+			msg := err.Error()
+			return operations.NewGetFixturesDefault(500).WithPayload(&models.Error{Code: 500, Message: &msg})
+		}
+
+		return operations.NewGetFixturesOK().WithPayload(fixturesToModel(fixtures))
+	}
+}
+
+func scheduleFixturesHandler(organizer *tournament.Organizer, competitionID int) operations.ScheduleFixturesHandlerFunc {
+	return func(params operations.ScheduleFixturesParams, principal *models.Principal) middleware.Responder {
+		theTournament, err := organizer.Tournament(competitionID)
+		if err != nil {
+			return competitionError(int64(competitionID), err)
+		}
+		fixtures, err := theTournament.ScheduleRoundRobin(params.Body.Teams, params.Body.Double)
+		if err != nil {
+			code := 400
+			if err == tournament.ErrFixturesExist || err == tournament.ErrSeasonClosed {
+				code = 409
+			}
+			msg := err.Error()
+			return operations.NewScheduleFixturesDefault(code).WithPayload(&models.Error{Code: int64(code), Message: &msg})
+		}
+
+		return operations.NewScheduleFixturesCreated().WithPayload(fixturesToModel(fixtures))
+	}
+}
+
+func pairSwissRoundHandler(organizer *tournament.Organizer, competitionID int) operations.PairSwissRoundHandlerFunc {
+	return func(params operations.PairSwissRoundParams, principal *models.Principal) middleware.Responder {
+		theTournament, err := organizer.Tournament(competitionID)
+		if err != nil {
+			return competitionError(int64(competitionID), err)
+		}
+		var teams []string
+		if params.Body != nil {
+			teams = params.Body.Teams
+		}
+
+		fixtures, err := theTournament.PairSwissRound(teams)
+		if err != nil {
+			code := 400
+			if err == tournament.ErrRoundNotComplete || err == tournament.ErrNoPairings || err == tournament.ErrSeasonClosed {
+				code = 409
+			}
+			msg := err.Error()
+			return operations.NewPairSwissRoundDefault(code).WithPayload(&models.Error{Code: int64(code), Message: &msg})
+		}
+
+		return operations.NewPairSwissRoundCreated().WithPayload(fixturesToModel(fixtures))
+	}
+}
+
+func fixturesToModel(fixtures []tournament.Fixture) []*models.Fixture {
+	payload := make([]*models.Fixture, 0, len(fixtures))
+	for _, f := range fixtures {
+		payload = append(payload, fixtureToModel(&f))
+	}
+	return payload
+}
+
+func fixtureToModel(f *tournament.Fixture) *models.Fixture {
+	return &models.Fixture{
+		ID:       int64(f.ID),
+		Round:    swag.Int64(int64(f.Round)),
+		HomeTeam: swag.String(f.HomeTeam),
+		AwayTeam: f.AwayTeam,
+		Status:   f.Status.String(),
+		GameID:   int64(f.GameID),
+	}
+}
+
+func updateFixtureStatusHandler(organizer *tournament.Organizer, competitionID int) operations.UpdateFixtureStatusHandlerFunc {
+	return func(params operations.UpdateFixtureStatusParams, principal *models.Principal) middleware.Responder {
+		theTournament, err := organizer.Tournament(competitionID)
+		if err != nil {
+			return competitionError(int64(competitionID), err)
+		}
+		status, err := tournament.ParseFixtureStatus(*params.Body.Status)
+		var fixture *tournament.Fixture
+		if err == nil {
+			fixture, err = theTournament.UpdateFixtureStatus(int(params.ID), status)
+		}
+		if err != nil {
+			payload := errorToModel(err)
+			return operations.NewUpdateFixtureStatusDefault(int(payload.Code)).WithPayload(payload)
+		}
+
+		return operations.NewUpdateFixtureStatusOK().WithPayload(fixtureToModel(fixture))
+	}
+}
+
+func recordFixtureResultHandler(organizer *tournament.Organizer, competitionID int) operations.RecordFixtureResultHandlerFunc {
+	return func(params operations.RecordFixtureResultParams, principal *models.Principal) middleware.Responder {
+		theTournament, err := organizer.Tournament(competitionID)
+		if err != nil {
+			return competitionError(int64(competitionID), err)
+		}
+		game := tournament.Game{
+			ScoreA:     int(*params.Body.HomeScore),
+			ScoreB:     int(*params.Body.AwayScore),
+			PenaltiesA: int(swag.Int64Value(params.Body.HomePenalties)),
+			PenaltiesB: int(swag.Int64Value(params.Body.AwayPenalties)),
+			PlayedAt:   time.Time(params.Body.PlayedAt),
+		}
+		if params.Body.DecidedIn != nil {
+			game.DecidedIn, err = tournament.ParsePeriod(*params.Body.DecidedIn)
+		}
+		if err == nil && params.Body.Outcome != nil {
+			game.Outcome, err = tournament.ParseOutcome(*params.Body.Outcome)
+		}
+		var played *tournament.Game
+		if err == nil {
+			played, err = theTournament.As(actor(params.HTTPRequest, principal)).RecordResult(int(params.ID), game)
+		}
+		if err != nil {
+			payload := errorToModel(err)
+			return operations.NewRecordFixtureResultDefault(int(payload.Code)).WithPayload(payload)
+		}
+
+		return operations.NewRecordFixtureResultCreated().WithPayload(gameToModel(played))
+	}
+}
+
+func createBracketHandler(organizer *tournament.Organizer, competitionID int) operations.CreateBracketHandlerFunc {
+	return func(params operations.CreateBracketParams, principal *models.Principal) middleware.Responder {
+		theTournament, err := organizer.Tournament(competitionID)
+		if err != nil {
+			return competitionError(int64(competitionID), err)
+		}
+		bracket, err := theTournament.CreateBracket(*params.Body.Name, params.Body.Teams)
+		if err != nil {
+			code := 400
+			if err == tournament.ErrSeasonClosed {
+				code = 409
+			}
+			msg := err.Error()
+			return operations.NewCreateBracketDefault(code).WithPayload(&models.Error{Code: int64(code), Message: &msg})
+		}
+
+		return operations.NewCreateBracketCreated().WithPayload(bracketToModel(bracket))
+	}
+}
+
+func getBracketHandler(organizer *tournament.Organizer, competitionID int) operations.GetBracketHandlerFunc {
+	return func(params operations.GetBracketParams) middleware.Responder {
+		theTournament, err := organizer.Tournament(competitionID)
+		if err != nil {
+			return competitionError(int64(competitionID), err)
+		}
+		bracket, err := theTournament.GetBracket(int(params.ID))
+		if err != nil {
+			if err == tournament.ErrBracketNotFound {
+				msg := fmt.Sprintf("Bracket '%d' not found", params.ID)
+				return operations.NewGetBracketDefault(404).WithPayload(&models.Error{Code: 404, Message: &msg})
+			} else {
+				msg := err.Error()
+				return operations.NewGetBracketDefault(500).WithPayload(&models.Error{Code: 500, Message: &msg})
+			}
+		}
+
+		return operations.NewGetBracketOK().WithPayload(bracketToModel(bracket))
+	}
+}
+
+func bracketToModel(bracket *tournament.Bracket) *models.Bracket {
+	var matchToModel func(m *tournament.BracketMatch) *models.BracketMatch
+	matchToModel = func(m *tournament.BracketMatch) *models.BracketMatch {
+		mm := &models.BracketMatch{
+			Round:    swag.Int64(int64(m.Round)),
+			Position: swag.Int64(int64(m.Position)),
+			TeamA:    m.TeamA,
+			TeamB:    m.TeamB,
+			Winner:   m.Winner,
+		}
+		for _, position := range []int{2 * m.Position, 2*m.Position + 1} {
+			if previous := bracket.Match(m.Round-1, position); previous != nil {
+				mm.Previous = append(mm.Previous, matchToModel(previous))
+			}
+		}
+		return mm
+	}
+
+	return &models.Bracket{
+		ID:    swag.Int64(int64(bracket.ID)),
+		Name:  swag.String(bracket.Name),
+		Final: matchToModel(bracket.Final()),
+	}
+}
+
+func createGroupStageHandler(organizer *tournament.Organizer, competitionID int) operations.CreateGroupStageHandlerFunc {
+	return func(params operations.CreateGroupStageParams, principal *models.Principal) middleware.Responder {
+		theTournament, err := organizer.Tournament(competitionID)
+		if err != nil {
+			return competitionError(int64(competitionID), err)
+		}
+		groups := make([]tournament.Group, 0, len(params.Body.Groups))
+		for _, g := range params.Body.Groups {
+			groups = append(groups, tournament.Group{Name: *g.Name, Teams: g.Teams})
+		}
+
+		stage, err := theTournament.CreateGroupStage(*params.Body.Name, groups, int(*params.Body.Qualifiers))
+		if err != nil {
+			code := 400
+			if err == tournament.ErrSeasonClosed {
+				code = 409
+			}
+			msg := err.Error()
+			return operations.NewCreateGroupStageDefault(code).WithPayload(&models.Error{Code: int64(code), Message: &msg})
+		}
+
+		payload, err := groupStageToModel(theTournament, stage)
+		if err != nil {
+			msg := err.Error()
+			return operations.NewCreateGroupStageDefault(500).WithPayload(&models.Error{Code: 500, Message: &msg})
+		}
+		return operations.NewCreateGroupStageCreated().WithPayload(payload)
+	}
+}
+
+func getGroupStageHandler(organizer *tournament.Organizer, competitionID int) operations.GetGroupStageHandlerFunc {
+	return func(params operations.GetGroupStageParams) middleware.Responder {
+		theTournament, err := organizer.Tournament(competitionID)
+		if err != nil {
+			return competitionError(int64(competitionID), err)
+		}
+		stage, err := theTournament.GetGroupStage(int(params.ID))
+		if err != nil {
+			if err == tournament.ErrGroupStageNotFound {
+				msg := fmt.Sprintf("Group stage '%d' not found", params.ID)
+				return operations.NewGetGroupStageDefault(404).WithPayload(&models.Error{Code: 404, Message: &msg})
+			} else {
+				msg := err.Error()
+				return operations.NewGetGroupStageDefault(500).WithPayload(&models.Error{Code: 500, Message: &msg})
+			}
+		}
+
+		payload, err := groupStageToModel(theTournament, stage)
+		if err != nil {
+			msg := err.Error()
+			return operations.NewGetGroupStageDefault(500).WithPayload(&models.Error{Code: 500, Message: &msg})
+		}
+		return operations.NewGetGroupStageOK().WithPayload(payload)
+	}
+}
+
+func groupStageToModel(theTournament *tournament.Tournament, stage *tournament.GroupStage) (*models.GroupStage, error) {
+	tables, err := theTournament.GetGroupTables(stage)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]*models.GroupTable, 0, len(tables))
+	for _, table := range tables {
+		standings := make([]*models.Stats, 0, len(table.Standings))
+		for _, s := range table.Standings {
+			standings = append(standings, statsToModel(s))
+		}
+		groups = append(groups, &models.GroupTable{Name: swag.String(table.Group), Standings: standings})
+	}
+
+	return &models.GroupStage{
+		ID:         swag.Int64(int64(stage.ID)),
+		Name:       swag.String(stage.Name),
+		Qualifiers: swag.Int64(int64(stage.Qualifiers)),
+		BracketID:  int64(stage.BracketID),
+		Groups:     groups,
+	}, nil
+}
+
+func listCompetitionsHandler(organizer *tournament.Organizer) operations.ListCompetitionsHandlerFunc {
+	return func(params operations.ListCompetitionsParams) middleware.Responder {
+		competitions, err := organizer.GetCompetitions()
+		if err != nil {
+			msg := err.Error()
+			return operations.NewListCompetitionsDefault(500).WithPayload(&models.Error{Code: 500, Message: &msg})
+		}
+
+		payload := make([]*models.Competition, 0, len(competitions))
+		for i := range competitions {
+			payload = append(payload, competitionToModel(&competitions[i]))
+		}
+		return operations.NewListCompetitionsOK().WithPayload(payload)
+	}
+}
+
+func createCompetitionHandler(organizer *tournament.Organizer, defaultRules tournament.ScoringRules) operations.CreateCompetitionHandlerFunc {
+	return func(params operations.CreateCompetitionParams, principal *models.Principal) middleware.Responder {
+		competition := tournament.Competition{
+			Name:    *params.Body.Name,
+			Season:  params.Body.Season,
+			Format:  tournament.Format(*params.Body.Format),
+			Scoring: defaultRules,
+			Teams:   params.Body.Teams,
+		}
+		if z := params.Body.Zones; z != nil {
+			competition.Zones = tournament.Zones{
+				Promotion:         int(swag.Int64Value(z.Promotion)),
+				PromotionPlayoff:  int(swag.Int64Value(z.PromotionPlayoff)),
+				RelegationPlayoff: int(swag.Int64Value(z.RelegationPlayoff)),
+				Relegation:        int(swag.Int64Value(z.Relegation)),
+			}
+		}
+		if s := params.Body.Scoring; s != nil {
+			competition.Scoring = tournament.ScoringRules{
+				Win:              int(*s.Win),
+				Draw:             int(*s.Draw),
+				Loss:             int(*s.Loss),
+				BigWinMargin:     int(swag.Int64Value(s.BigWinMargin)),
+				BigWinBonus:      int(s.BigWinBonus),
+				ForfeitDeduction: int(swag.Int64Value(s.ForfeitDeduction)),
+				Overtime:         s.Overtime,
+				OvertimeWin:      int(s.OvertimeWin),
+				OvertimeLoss:     int(s.OvertimeLoss),
+			}
+		}
+
+		created, err := organizer.CreateCompetition(competition)
+		if err != nil {
+			msg := err.Error()
+			return operations.NewCreateCompetitionDefault(400).WithPayload(&models.Error{Code: 400, Message: &msg})
+		}
+		return operations.NewCreateCompetitionCreated().WithPayload(competitionToModel(created))
+	}
+}
+
+func getCompetitionHandler(organizer *tournament.Organizer) operations.GetCompetitionHandlerFunc {
+	return func(params operations.GetCompetitionParams) middleware.Responder {
+		competition, err := organizer.GetCompetition(int(params.ID))
+		if err != nil {
+			return competitionError(params.ID, err)
+		}
+		return operations.NewGetCompetitionOK().WithPayload(competitionToModel(competition))
+	}
+}
+
+func closeSeasonHandler(organizer *tournament.Organizer) operations.CloseSeasonHandlerFunc {
+	return func(params operations.CloseSeasonParams, principal *models.Principal) middleware.Responder {
+		standings, err := organizer.CloseSeason(int(params.ID))
+		if err != nil {
+			if err == tournament.ErrSeasonClosed {
+				msg := err.Error()
+				return operations.NewCloseSeasonDefault(409).WithPayload(&models.Error{Code: 409, Message: &msg})
+			}
+			return competitionError(params.ID, err)
+		}
+
+		payload := make([]*models.Stats, 0, len(standings))
+		for _, s := range standings {
+			payload = append(payload, statsToModel(s))
+		}
+		return operations.NewCloseSeasonOK().WithPayload(payload)
+	}
+}
+
+func rolloverSeasonHandler(organizer *tournament.Organizer) operations.RolloverSeasonHandlerFunc {
+	return func(params operations.RolloverSeasonParams, principal *models.Principal) middleware.Responder {
+		rollover := tournament.Rollover{
+			Season:        *params.Body.Season,
+			Promotions:    int(swag.Int64Value(params.Body.Promotions)),
+			PlayoffMovers: params.Body.PlayoffMovers,
+		}
+		for _, id := range params.Body.Divisions {
+			rollover.Divisions = append(rollover.Divisions, int(id))
+		}
+
+		competitions, err := organizer.RolloverSeason(rollover)
+		if err != nil {
+			code := 400
+			switch err {
+			case tournament.ErrCompetitionNotFound:
+				code = 404
+			case tournament.ErrSeasonNotClosed:
+				code = 409
+			}
+			msg := err.Error()
+			return operations.NewRolloverSeasonDefault(code).WithPayload(&models.Error{Code: int64(code), Message: &msg})
+		}
+
+		payload := make([]*models.Competition, 0, len(competitions))
+		for i := range competitions {
+			payload = append(payload, competitionToModel(&competitions[i]))
+		}
+		return operations.NewRolloverSeasonCreated().WithPayload(payload)
+	}
+}
+
+func getCompetitionMovementsHandler(organizer *tournament.Organizer) operations.GetCompetitionMovementsHandlerFunc {
+	return func(params operations.GetCompetitionMovementsParams) middleware.Responder {
+		movements, err := organizer.Movements(int(params.ID))
+		if err != nil {
+			return competitionError(params.ID, err)
+		}
+		return operations.NewGetCompetitionMovementsOK().WithPayload(&models.Movements{
+			Promoted:          movements.Promoted,
+			PromotionPlayoff:  movements.PromotionPlayoff,
+			RelegationPlayoff: movements.RelegationPlayoff,
+			Relegated:         movements.Relegated,
+		})
+	}
+}
+
+func listTeamsHandler(registry *tournament.TeamRegistry) operations.ListTeamsHandlerFunc {
+	return func(params operations.ListTeamsParams) middleware.Responder {
+		teams, err := registry.GetTeams()
+		if err != nil {
+			msg := err.Error()
+			return operations.NewListTeamsDefault(500).WithPayload(&models.Error{Code: 500, Message: &msg})
+		}
+
+		payload := make([]*models.Team, 0, len(teams))
+		for i := range teams {
+			payload = append(payload, teamToModel(&teams[i]))
+		}
+		return operations.NewListTeamsOK().WithPayload(payload)
+	}
+}
+
+func createTeamHandler(registry *tournament.TeamRegistry) operations.CreateTeamHandlerFunc {
+	return func(params operations.CreateTeamParams, principal *models.Principal) middleware.Responder {
+		created, err := registry.CreateTeam(teamFromModel(params.Body))
+		if err != nil {
+			payload := errorToModel(err)
+			return operations.NewCreateTeamDefault(int(payload.Code)).WithPayload(payload)
+		}
+		return operations.NewCreateTeamCreated().WithPayload(teamToModel(created))
+	}
+}
+
+func getTeamHandler(registry *tournament.TeamRegistry) operations.GetTeamHandlerFunc {
+	return func(params operations.GetTeamParams) middleware.Responder {
+		team, err := registry.GetTeam(int(params.ID))
+		if err != nil {
+			payload := errorToModel(err)
+			return operations.NewGetTeamDefault(int(payload.Code)).WithPayload(payload)
+		}
+		return operations.NewGetTeamOK().WithPayload(teamToModel(team))
+	}
+}
+
+func updateTeamHandler(registry *tournament.TeamRegistry) operations.UpdateTeamHandlerFunc {
+	return func(params operations.UpdateTeamParams, principal *models.Principal) middleware.Responder {
+		team := teamFromModel(params.Body)
+		team.ID = int(params.ID)
+
+		updated, err := registry.As(actor(params.HTTPRequest, principal)).UpdateTeam(team)
+		if err != nil {
+			payload := errorToModel(err)
+			return operations.NewUpdateTeamDefault(int(payload.Code)).WithPayload(payload)
+		}
+		return operations.NewUpdateTeamOK().WithPayload(teamToModel(updated))
+	}
+}
+
+func deleteTeamHandler(registry *tournament.TeamRegistry) operations.DeleteTeamHandlerFunc {
+	return func(params operations.DeleteTeamParams, principal *models.Principal) middleware.Responder {
+		if err := registry.DeleteTeam(int(params.ID)); err != nil {
+			payload := errorToModel(err)
+			return operations.NewDeleteTeamDefault(int(payload.Code)).WithPayload(payload)
+		}
+		return operations.NewDeleteTeamNoContent()
+	}
+}
+
+func renameTeamHandler(registry *tournament.TeamRegistry) operations.RenameTeamHandlerFunc {
+	return func(params operations.RenameTeamParams, principal *models.Principal) middleware.Responder {
+		renamed, err := registry.As(actor(params.HTTPRequest, principal)).RenameTeam(int(params.ID), *params.Body.Name)
+		if err != nil {
+			payload := errorToModel(err)
+			return operations.NewRenameTeamDefault(int(payload.Code)).WithPayload(payload)
+		}
+		return operations.NewRenameTeamOK().WithPayload(teamToModel(renamed))
+	}
+}
+
+func mergeTeamsHandler(registry *tournament.TeamRegistry) operations.MergeTeamsHandlerFunc {
+	return func(params operations.MergeTeamsParams, principal *models.Principal) middleware.Responder {
+		merged, err := registry.As(actor(params.HTTPRequest, principal)).MergeTeams(int(*params.Body.From), int(params.ID))
+		if err != nil {
+			payload := errorToModel(err)
+			return operations.NewMergeTeamsDefault(int(payload.Code)).WithPayload(payload)
+		}
+		return operations.NewMergeTeamsOK().WithPayload(teamToModel(merged))
+	}
+}
+
+func getTeamHistoryHandler(registry *tournament.TeamRegistry) operations.GetTeamHistoryHandlerFunc {
+	return func(params operations.GetTeamHistoryParams) middleware.Responder {
+		history, err := registry.TeamHistory(int(params.ID))
+		if err != nil {
+			payload := errorToModel(err)
+			return operations.NewGetTeamHistoryDefault(int(payload.Code)).WithPayload(payload)
+		}
+
+		payload := make([]*models.TeamChange, 0, len(history))
+		for _, c := range history {
+			at := strfmt.DateTime(c.At)
+			payload = append(payload, &models.TeamChange{
+				ID:        swag.Int64(int64(c.ID)),
+				TeamID:    swag.Int64(int64(c.TeamID)),
+				Action:    swag.String(string(c.Action)),
+				From:      swag.String(c.From),
+				To:        swag.String(c.To),
+				Principal: swag.String(c.Principal),
+				RequestID: c.RequestID,
+				At:        &at,
+			})
+		}
+		return operations.NewGetTeamHistoryOK().WithPayload(payload)
+	}
+}
+
+func teamFromModel(m *models.Team) tournament.Team {
+	return tournament.Team{
+		Name:      *m.Name,
+		ShortCode: m.ShortCode,
+		Aliases:   m.Aliases,
+	}
+}
+
+func teamToModel(team *tournament.Team) *models.Team {
+	return &models.Team{
+		ID:        int64(team.ID),
+		Name:      swag.String(team.Name),
+		ShortCode: team.ShortCode,
+		Aliases:   team.Aliases,
+	}
+}
+
+func listWebhooksHandler(registry *tournament.WebhookRegistry) operations.ListWebhooksHandlerFunc {
+	return func(params operations.ListWebhooksParams, principal *models.Principal) middleware.Responder {
+		webhooks, err := registry.GetWebhooks()
+		if err != nil {
+			msg := err.Error()
+			return operations.NewListWebhooksDefault(500).WithPayload(&models.Error{Code: 500, Message: &msg})
+		}
+
+		payload := make([]*models.Webhook, 0, len(webhooks))
+		for i := range webhooks {
+			payload = append(payload, webhookToModel(&webhooks[i], false))
+		}
+		return operations.NewListWebhooksOK().WithPayload(payload)
+	}
+}
+
+func createWebhookHandler(registry *tournament.WebhookRegistry) operations.CreateWebhookHandlerFunc {
+	return func(params operations.CreateWebhookParams, principal *models.Principal) middleware.Responder {
+		created, err := registry.CreateWebhook(webhookFromModel(params.Body))
+		if err != nil {
+			payload := errorToModel(err)
+			return operations.NewCreateWebhookDefault(int(payload.Code)).WithPayload(payload)
+		}
+		return operations.NewCreateWebhookCreated().WithPayload(webhookToModel(created, true))
+	}
+}
+
+func getWebhookHandler(registry *tournament.WebhookRegistry) operations.GetWebhookHandlerFunc {
+	return func(params operations.GetWebhookParams, principal *models.Principal) middleware.Responder {
+		webhook, err := registry.GetWebhook(int(params.ID))
+		if err != nil {
+			payload := errorToModel(err)
+			return operations.NewGetWebhookDefault(int(payload.Code)).WithPayload(payload)
+		}
+		return operations.NewGetWebhookOK().WithPayload(webhookToModel(webhook, false))
+	}
+}
+
+func updateWebhookHandler(registry *tournament.WebhookRegistry) operations.UpdateWebhookHandlerFunc {
+	return func(params operations.UpdateWebhookParams, principal *models.Principal) middleware.Responder {
+		webhook := webhookFromModel(params.Body)
+		webhook.ID = int(params.ID)
+
+		updated, err := registry.UpdateWebhook(webhook)
+		if err != nil {
+			payload := errorToModel(err)
+			return operations.NewUpdateWebhookDefault(int(payload.Code)).WithPayload(payload)
+		}
+		return operations.NewUpdateWebhookOK().WithPayload(webhookToModel(updated, false))
+	}
+}
+
+func deleteWebhookHandler(registry *tournament.WebhookRegistry) operations.DeleteWebhookHandlerFunc {
+	return func(params operations.DeleteWebhookParams, principal *models.Principal) middleware.Responder {
+		if err := registry.DeleteWebhook(int(params.ID)); err != nil {
+			payload := errorToModel(err)
+			return operations.NewDeleteWebhookDefault(int(payload.Code)).WithPayload(payload)
+		}
+		return operations.NewDeleteWebhookNoContent()
+	}
+}
+
+func getWebhookDeliveriesHandler(registry *tournament.WebhookRegistry) operations.GetWebhookDeliveriesHandlerFunc {
+	return func(params operations.GetWebhookDeliveriesParams, principal *models.Principal) middleware.Responder {
+		filter := tournament.DeliveryFilter{Limit: int(*params.Limit)}
+		if params.Status != nil {
+			filter.Status = tournament.DeliveryStatus(*params.Status)
+		}
+		deliveries, err := registry.GetDeliveries(int(params.ID), filter)
+		if err != nil {
+			payload := errorToModel(err)
+			return operations.NewGetWebhookDeliveriesDefault(int(payload.Code)).WithPayload(payload)
+		}
+
+		payload := make([]*models.WebhookDelivery, 0, len(deliveries))
+		for i := range deliveries {
+			payload = append(payload, deliveryToModel(&deliveries[i]))
+		}
+		return operations.NewGetWebhookDeliveriesOK().WithPayload(payload)
+	}
+}
+
+func retryWebhookDeliveryHandler(registry *tournament.WebhookRegistry) operations.RetryWebhookDeliveryHandlerFunc {
+	return func(params operations.RetryWebhookDeliveryParams, principal *models.Principal) middleware.Responder {
+		delivery, err := registry.RetryDelivery(int(params.ID))
+		if err != nil {
+			payload := errorToModel(err)
+			return operations.NewRetryWebhookDeliveryDefault(int(payload.Code)).WithPayload(payload)
+		}
+		return operations.NewRetryWebhookDeliveryOK().WithPayload(deliveryToModel(delivery))
+	}
+}
+
+func webhookFromModel(m *models.Webhook) tournament.Webhook {
+	webhook := tournament.Webhook{
+		URL:    *m.URL,
+		Secret: m.Secret,
+		Active: m.Active == nil || *m.Active,
+	}
+	for _, e := range m.Events {
+		webhook.Events = append(webhook.Events, tournament.EventType(e))
+	}
+	return webhook
+}
+
+// webhookToModel returns the webhook, with its secret only when withSecret is
+// set, on creation.
+func webhookToModel(webhook *tournament.Webhook, withSecret bool) *models.Webhook {
+	m := &models.Webhook{
+		ID:        int64(webhook.ID),
+		URL:       swag.String(webhook.URL),
+		Events:    []string{},
+		Active:    swag.Bool(webhook.Active),
+		CreatedAt: strfmt.DateTime(webhook.CreatedAt),
+	}
+	for _, e := range webhook.Events {
+		m.Events = append(m.Events, string(e))
+	}
+	if withSecret {
+		m.Secret = webhook.Secret
+	}
+	return m
+}
+
+func deliveryToModel(delivery *tournament.WebhookDelivery) *models.WebhookDelivery {
+	createdAt := strfmt.DateTime(delivery.CreatedAt)
+	m := &models.WebhookDelivery{
+		ID:        swag.Int64(int64(delivery.ID)),
+		WebhookID: swag.Int64(int64(delivery.WebhookID)),
+		EventID:   swag.Int64(delivery.Event.ID),
+		Event:     swag.String(string(delivery.Event.Type)),
+		Status:    swag.String(string(delivery.Status)),
+		Attempts:  swag.Int64(int64(delivery.Attempts)),
+		CreatedAt: &createdAt,
+		Log:       []*models.DeliveryAttempt{},
+	}
+	if delivery.Status == tournament.DeliveryPending {
+		m.NextAttemptAt = strfmt.DateTime(delivery.NextAttemptAt)
+	}
+	for _, a := range delivery.Log {
+		at := strfmt.DateTime(a.At)
+		m.Log = append(m.Log, &models.DeliveryAttempt{
+			Attempt:    swag.Int64(int64(a.Attempt)),
+			StatusCode: int64(a.StatusCode),
+			Error:      a.Error,
+			DurationMs: swag.Int64(a.Duration.Milliseconds()),
+			At:         &at,
+		})
+	}
+	return m
+}
+
+// standingsLeader returns the team at the top of the standings of the
+// competition, empty when it has no teams.
+func standingsLeader(organizer *tournament.Organizer, competitionID int) (string, error) {
+	theTournament, err := organizer.Tournament(competitionID)
+	if err != nil {
+		return "", err
+	}
+	standings, err := theTournament.GetAllStats()
+	if err != nil || len(standings) == 0 {
+		return "", err
+	}
+	return standings[0].Team, nil
+}
+
+// The handlers of the competition endpoints hand over to the handlers of the
+// default competition, serving the competition instead.
+
+func playInCompetitionHandler(organizer *tournament.Organizer) operations.PlayInCompetitionHandlerFunc {
+	return func(params operations.PlayInCompetitionParams, principal *models.Principal) middleware.Responder {
+		return playHandler(organizer, int(params.ID))(operations.PlayParams{HTTPRequest: params.HTTPRequest, Body: params.Body}, principal)
+	}
+}
+
+func getCompetitionStatsHandler(organizer *tournament.Organizer) operations.GetCompetitionStatsHandlerFunc {
+	return func(params operations.GetCompetitionStatsParams) middleware.Responder {
+		return getAllStatsHandler(organizer, int(params.ID))(operations.GetAllStatsParams{HTTPRequest: params.HTTPRequest})
+	}
+}
+
+func getCompetitionTeamStatsHandler(organizer *tournament.Organizer) operations.GetCompetitionTeamStatsHandlerFunc {
+	return func(params operations.GetCompetitionTeamStatsParams) middleware.Responder {
+		return getTeamStatsHandler(organizer, int(params.ID))(operations.GetTeamStatsParams{HTTPRequest: params.HTTPRequest, Team: params.Team})
+	}
+}
+
+func getCompetitionFixturesHandler(organizer *tournament.Organizer) operations.GetCompetitionFixturesHandlerFunc {
+	return func(params operations.GetCompetitionFixturesParams) middleware.Responder {
+		return getFixturesHandler(organizer, int(params.ID))(operations.GetFixturesParams{HTTPRequest: params.HTTPRequest, Status: params.Status})
+	}
+}
+
+func competitionError(id int64, err error) middleware.Responder {
+	if err == tournament.ErrCompetitionNotFound {
+		msg := fmt.Sprintf("Competition '%d' not found", id)
+		return operations.NewGetCompetitionDefault(404).WithPayload(&models.Error{Code: 404, Message: &msg})
+	}
+	msg := err.Error()
+	return operations.NewGetCompetitionDefault(500).WithPayload(&models.Error{Code: 500, Message: &msg})
+}
+
+func competitionToModel(competition *tournament.Competition) *models.Competition {
+	return &models.Competition{
+		ID:     swag.Int64(int64(competition.ID)),
+		Name:   swag.String(competition.Name),
+		Season: swag.String(competition.Season),
+		Format: swag.String(string(competition.Format)),
+		Scoring: &models.ScoringRules{
+			Win:              swag.Int64(int64(competition.Scoring.Win)),
+			Draw:             swag.Int64(int64(competition.Scoring.Draw)),
+			Loss:             swag.Int64(int64(competition.Scoring.Loss)),
+			BigWinMargin:     swag.Int64(int64(competition.Scoring.BigWinMargin)),
+			BigWinBonus:      int64(competition.Scoring.BigWinBonus),
+			ForfeitDeduction: swag.Int64(int64(competition.Scoring.ForfeitDeduction)),
+			Overtime:         competition.Scoring.Overtime,
+			OvertimeWin:      int64(competition.Scoring.OvertimeWin),
+			OvertimeLoss:     int64(competition.Scoring.OvertimeLoss),
+		},
+		Zones: &models.Zones{
+			Promotion:         swag.Int64(int64(competition.Zones.Promotion)),
+			PromotionPlayoff:  swag.Int64(int64(competition.Zones.PromotionPlayoff)),
+			RelegationPlayoff: swag.Int64(int64(competition.Zones.RelegationPlayoff)),
+			Relegation:        swag.Int64(int64(competition.Zones.Relegation)),
+		},
+		Teams:  competition.Teams,
+		Closed: competition.Closed,
+	}
+}
+
+// apiKeys maps the API keys to the principals recorded in the audit log, so
+// that the keys themselves are not stored.
+var apiKeys = map[string]models.Principal{
+	"qwerty": "admin",
+}
+
 func keyAuth(token string) (*models.Principal, error) {
-	if token == "qwerty" {
-		p := models.Principal(token)
+	if p, ok := apiKeys[token]; ok {
 		return &p, nil
 	}
 	return nil, errors.New(401, "Incorrect API key auth")
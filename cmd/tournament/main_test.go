@@ -0,0 +1,127 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
+	"github.com/slawekzachcial/tournament/internal/gen/models"
+	"github.com/slawekzachcial/tournament/internal/gen/restapi/operations"
+	"github.com/slawekzachcial/tournament/internal/tournament"
+)
+
+type CompetitionsArray struct {
+	competitions []tournament.Competition
+}
+
+func (ca *CompetitionsArray) Save(competition *tournament.Competition) error {
+	competition.ID = len(ca.competitions) + 1
+	ca.competitions = append(ca.competitions, *competition)
+	return nil
+}
+
+func (ca *CompetitionsArray) FindByID(id int) (*tournament.Competition, error) {
+	for _, c := range ca.competitions {
+		if c.ID == id {
+			return &c, nil
+		}
+	}
+	return nil, tournament.ErrCompetitionNotFound
+}
+
+func (ca *CompetitionsArray) FindAll() ([]tournament.Competition, error) {
+	return ca.competitions, nil
+}
+
+func (ca *CompetitionsArray) UpdateScoring(id int, rules tournament.ScoringRules) error {
+	ca.competitions[id-1].Scoring = rules
+	return nil
+}
+
+func (ca *CompetitionsArray) Close(id int, standings []tournament.Stats) error {
+	ca.competitions[id-1].Closed = true
+	return nil
+}
+
+func (ca *CompetitionsArray) FindStandings(id int) ([]tournament.Stats, error) {
+	return []tournament.Stats{}, nil
+}
+
+func newOrganizer() (*tournament.Organizer, *CompetitionsArray) {
+	competitions := &CompetitionsArray{}
+	competitions.Save(&tournament.Competition{Name: "Default", Format: tournament.LeagueFormat})
+	return tournament.NewOrganizer(competitions, func(c *tournament.Competition) *tournament.Tournament {
+		return tournament.NewTournament(nil)
+	}), competitions
+}
+
+func TestGetFixturesUnknownStatus(t *testing.T) {
+	organizer, _ := newOrganizer()
+	handler := getFixturesHandler(organizer, tournament.DefaultCompetitionID)
+
+	rec := httptest.NewRecorder()
+	handler(operations.GetFixturesParams{Status: swag.String("unknown")}).WriteResponse(rec, runtime.JSONProducer())
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected status %v for unknown fixture status, got %v: %s", http.StatusBadRequest, rec.Code, rec.Body)
+	}
+}
+
+func TestPlayAfterSeasonClosed(t *testing.T) {
+	organizer, competitions := newOrganizer()
+	handler := playHandler(organizer, tournament.DefaultCompetitionID)
+	competitions.Close(tournament.DefaultCompetitionID, nil)
+
+	rec := httptest.NewRecorder()
+	principal := models.Principal("admin")
+	params := operations.PlayParams{
+		HTTPRequest: httptest.NewRequest(http.MethodPost, "/games", nil),
+		Body:        &models.Game{TeamA: swag.String("a"), ScoreA: swag.Int64(1), TeamB: swag.String("b"), ScoreB: swag.Int64(0)},
+	}
+	handler(params, &principal).WriteResponse(rec, runtime.JSONProducer())
+
+	if rec.Code != http.StatusConflict {
+		t.Errorf("Expected status %v playing after the season closed, got %v: %s", http.StatusConflict, rec.Code, rec.Body)
+	}
+}
+
+func TestDefaultCompetitionScoredWithFlags(t *testing.T) {
+	flag.Set("win-points", "2")
+	defer flag.Set("win-points", "3")
+	organizer, _ := newOrganizer()
+
+	if err := organizer.UpdateScoring(tournament.DefaultCompetitionID, scoringRulesFromFlags()); err != nil {
+		t.Fatalf("Unexpected error updating scoring rules: %v", err)
+	}
+
+	competition, _ := organizer.GetCompetition(tournament.DefaultCompetitionID)
+	if competition.Scoring.Win != 2 || competition.Scoring.Draw != 1 {
+		t.Errorf("Expected default competition scored with the flags, got %v", competition.Scoring)
+	}
+}
+
+func TestErrorCode(t *testing.T) {
+	_, parseErr := tournament.ParseOutcome("unknown")
+	var errorCodeTestData = []struct {
+		testName string
+		err      error
+		code     int
+	}{
+		{"validation", &tournament.ValidationError{}, http.StatusUnprocessableEntity},
+		{"unknown name", parseErr, http.StatusBadRequest},
+		{"not found", tournament.ErrFixtureNotFound, http.StatusNotFound},
+		{"conflict", tournament.ErrSeasonClosed, http.StatusConflict},
+		{"bad request", tournament.ErrByeFixture, http.StatusBadRequest},
+		{"repository failure", errors.New("connection refused"), http.StatusInternalServerError},
+	}
+
+	for _, testData := range errorCodeTestData {
+		if code := errorCode(testData.err); code != testData.code {
+			t.Errorf("%v: expected status %v, got %v", testData.testName, testData.code, code)
+		}
+	}
+}
@@ -2,11 +2,20 @@ package db
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/slawekzachcial/tournament/internal/tournament"
@@ -41,26 +50,229 @@ func RunMigrations(folder, dbUrl string) error {
 }
 
 type GamesData struct {
-	pool *pgxpool.Pool
+	pool          *pgxpool.Pool
+	competitionID int
 }
 
+// NewGameData returns the repository of the default competition.
 func NewGameData(p *pgxpool.Pool) *GamesData {
-	return &GamesData{p}
+	return &GamesData{p, tournament.DefaultCompetitionID}
+}
+
+// ForCompetition returns the repository of the competition.
+func (r *GamesData) ForCompetition(competitionID int) *GamesData {
+	return &GamesData{r.pool, competitionID}
 }
 
+const gameColumns = "g.id, ta.name, g.score_a, tb.name, g.score_b, g.decided_in, g.penalties_a, g.penalties_b, g.outcome, g.played_at, g.recorded_at"
+
+// gamesTable joins the games with the teams they reference by ID.
+const gamesTable = "games g JOIN teams ta ON ta.id = g.team_a_id JOIN teams tb ON tb.id = g.team_b_id"
+
 func (g *GamesData) Save(game *tournament.Game) error {
-	_, err := g.pool.Exec(context.Background(), "INSERT INTO games(team_a, score_a, team_b, score_b) VALUES ($1, $2, $3, $4)",
-		game.TeamA, game.ScoreA, game.TeamB, game.ScoreB)
+	return g.save(game, nil, nil)
+}
+
+// SaveAudited saves the game recording the audit entry in the same
+// transaction.
+func (g *GamesData) SaveAudited(game *tournament.Game, entry *tournament.AuditEntry) error {
+	return g.save(game, entry, nil)
+}
+
+// SaveFixtureGame saves the game of the fixture and completes the fixture with
+// it in the same transaction.
+func (g *GamesData) SaveFixtureGame(game *tournament.Game, fixture *tournament.Fixture, entry *tournament.AuditEntry) error {
+	return g.save(game, entry, fixture)
+}
+
+// save saves the game recording the audit entry and completing the fixture
+// with the game, when set.
+func (g *GamesData) save(game *tournament.Game, entry *tournament.AuditEntry, fixture *tournament.Fixture) error {
+	tx, err := g.pool.Begin(context.Background())
 	if err != nil {
 		return err
 	}
-	return nil
+	defer tx.Rollback(context.Background())
+
+	teamAID, teamBID, err := gameTeamIDs(tx, game)
+	if err != nil {
+		return err
+	}
+	err = tx.QueryRow(context.Background(),
+		"INSERT INTO games(competition_id, team_a_id, score_a, team_b_id, score_b, decided_in, penalties_a, penalties_b, outcome, played_at) "+
+			"VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, COALESCE($10, now())) RETURNING id, played_at, recorded_at",
+		g.competitionID, teamAID, game.ScoreA, teamBID, game.ScoreB, game.DecidedIn.String(), game.PenaltiesA, game.PenaltiesB,
+		game.Outcome.String(), nullIfZeroTime(game.PlayedAt)).Scan(&game.ID, &game.PlayedAt, &game.RecordedAt)
+	if err != nil {
+		return err
+	}
+	if err := saveOutboxEvent(tx, &tournament.OutboxEvent{CompetitionID: g.competitionID, Type: tournament.GameCreated, Game: game}); err != nil {
+		return err
+	}
+	if err := recordGameChange(tx, g.competitionID, entry, game.ID, game); err != nil {
+		return err
+	}
+	if fixture != nil {
+		fixture.GameID = game.ID
+		if err := updateFixture(tx, g.competitionID, fixture); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(context.Background())
+}
+
+// Update replaces the recorded game, keeping the time it was played when not
+// set.
+func (g *GamesData) Update(game *tournament.Game) error {
+	return g.update(game, nil, nil)
+}
+
+// UpdateAudited replaces the recorded game recording the audit entry in the
+// same transaction.
+func (g *GamesData) UpdateAudited(game *tournament.Game, entry *tournament.AuditEntry) error {
+	return g.update(game, entry, nil)
+}
+
+// UpdateFixtureGame replaces the recorded game of the fixture and completes
+// the fixture with it in the same transaction.
+func (g *GamesData) UpdateFixtureGame(game *tournament.Game, fixture *tournament.Fixture, entry *tournament.AuditEntry) error {
+	return g.update(game, entry, fixture)
+}
+
+// update replaces the recorded game recording the audit entry and completing
+// the fixture with the game, when set.
+func (g *GamesData) update(game *tournament.Game, entry *tournament.AuditEntry, fixture *tournament.Fixture) error {
+	tx, err := g.pool.Begin(context.Background())
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(context.Background())
+
+	teamAID, teamBID, err := gameTeamIDs(tx, game)
+	if err != nil {
+		return err
+	}
+	err = tx.QueryRow(context.Background(),
+		"UPDATE games SET team_a_id=$3, score_a=$4, team_b_id=$5, score_b=$6, decided_in=$7, penalties_a=$8, penalties_b=$9, outcome=$10, "+
+			"played_at=COALESCE($11, played_at) WHERE competition_id=$1 AND id=$2 RETURNING played_at, recorded_at",
+		g.competitionID, game.ID, teamAID, game.ScoreA, teamBID, game.ScoreB, game.DecidedIn.String(), game.PenaltiesA, game.PenaltiesB,
+		game.Outcome.String(), nullIfZeroTime(game.PlayedAt)).Scan(&game.PlayedAt, &game.RecordedAt)
+	if err == pgx.ErrNoRows {
+		return tournament.ErrGameNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if err := saveOutboxEvent(tx, &tournament.OutboxEvent{CompetitionID: g.competitionID, Type: tournament.GameUpdated, Game: game}); err != nil {
+		return err
+	}
+	if err := recordGameChange(tx, g.competitionID, entry, game.ID, game); err != nil {
+		return err
+	}
+	if fixture != nil {
+		fixture.GameID = game.ID
+		if err := updateFixture(tx, g.competitionID, fixture); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(context.Background())
+}
+
+func gameTeamIDs(tx pgx.Tx, game *tournament.Game) (int, int, error) {
+	teamAID, err := teamID(tx, game.TeamA)
+	if err != nil {
+		return 0, 0, err
+	}
+	teamBID, err := teamID(tx, game.TeamB)
+	if err != nil {
+		return 0, 0, err
+	}
+	return teamAID, teamBID, nil
+}
+
+// teamID returns the ID of the team with the name or alias, registering a new
+// team when there is none. A team registered concurrently is returned by the
+// no-op update of the conflicting row.
+func teamID(tx pgx.Tx, name string) (int, error) {
+	var id int
+	err := tx.QueryRow(context.Background(),
+		"WITH found AS ("+
+			"SELECT id FROM teams WHERE lower(name)=lower($1) "+
+			"UNION ALL SELECT team_id FROM team_aliases WHERE lower(alias)=lower($1)"+
+			"), registered AS ("+
+			"INSERT INTO teams(name) SELECT $1 WHERE NOT EXISTS (SELECT 1 FROM found) "+
+			"ON CONFLICT ((lower(name))) DO UPDATE SET name=teams.name RETURNING id"+
+			") SELECT id FROM found UNION ALL SELECT id FROM registered LIMIT 1",
+		name).Scan(&id)
+	return id, err
+}
+
+// Delete removes the game, telling about it with the game as it was.
+func (g *GamesData) Delete(id int) error {
+	return g.delete(id, nil)
+}
+
+// DeleteAudited removes the game recording the audit entry in the same
+// transaction.
+func (g *GamesData) DeleteAudited(id int, entry *tournament.AuditEntry) error {
+	return g.delete(id, entry)
+}
+
+func (g *GamesData) delete(id int, entry *tournament.AuditEntry) error {
+	tx, err := g.pool.Begin(context.Background())
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(context.Background())
+
+	rows, err := tx.Query(context.Background(),
+		"WITH g AS (DELETE FROM games WHERE competition_id=$1 AND id=$2 RETURNING *) "+
+			"SELECT "+gameColumns+" FROM g JOIN teams ta ON ta.id = g.team_a_id JOIN teams tb ON tb.id = g.team_b_id",
+		g.competitionID, id)
+	if err != nil {
+		return err
+	}
+	games, err := rowsToGames(rows)
+	rows.Close()
+	if err != nil {
+		return err
+	}
+	if len(games) == 0 {
+		return tournament.ErrGameNotFound
+	}
+	if err := saveOutboxEvent(tx, &tournament.OutboxEvent{CompetitionID: g.competitionID, Type: tournament.GameDeleted, Game: &games[0]}); err != nil {
+		return err
+	}
+	if err := recordGameChange(tx, g.competitionID, entry, id, nil); err != nil {
+		return err
+	}
+	return tx.Commit(context.Background())
+}
+
+func (g *GamesData) FindByID(id int) (*tournament.Game, error) {
+	rows, err := g.pool.Query(context.Background(),
+		"SELECT "+gameColumns+" FROM "+gamesTable+" WHERE g.competition_id=$1 AND g.id=$2",
+		g.competitionID, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	games, err := rowsToGames(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(games) == 0 {
+		return nil, tournament.ErrGameNotFound
+	}
+	return &games[0], nil
 }
 
 func (g *GamesData) FindByTeam(team string) ([]tournament.Game, error) {
 	rows, err := g.pool.Query(context.Background(),
-		"SELECT team_a, score_a, team_b, score_b FROM games WHERE team_a=$1 OR team_b=$1",
-		team)
+		"SELECT "+gameColumns+" FROM "+gamesTable+" WHERE g.competition_id=$1 AND "+
+			"(g.team_a_id IN "+teamIDsOf("$2")+" OR g.team_b_id IN "+teamIDsOf("$2")+") ORDER BY g.id",
+		g.competitionID, team)
 	if err != nil {
 		return nil, err
 	}
@@ -73,9 +285,22 @@ func (g *GamesData) FindByTeam(team string) ([]tournament.Game, error) {
 	return games, err
 }
 
+func (g *GamesData) FindTeam(name string) (string, error) {
+	var team string
+	err := g.pool.QueryRow(context.Background(),
+		"SELECT t.name FROM teams t WHERE t.id IN "+teamIDsOf("$2")+" AND EXISTS ("+
+			"SELECT 1 FROM games g WHERE g.competition_id=$1 AND (g.team_a_id=t.id OR g.team_b_id=t.id))",
+		g.competitionID, name).Scan(&team)
+	if err == pgx.ErrNoRows {
+		return "", tournament.ErrTeamNotFound
+	}
+	return team, err
+}
+
 func (g *GamesData) FindAll() ([]tournament.Game, error) {
 	rows, err := g.pool.Query(context.Background(),
-		"SELECT team_a, score_a, team_b, score_b FROM games")
+		"SELECT "+gameColumns+" FROM "+gamesTable+" WHERE g.competition_id=$1 ORDER BY g.id",
+		g.competitionID)
 	if err != nil {
 		return nil, err
 	}
@@ -84,18 +309,1686 @@ func (g *GamesData) FindAll() ([]tournament.Game, error) {
 	return rowsToGames(rows)
 }
 
+// teamWins tells whether the team playing as side won the game against the
+// other side like Game.ResultFor: no team wins a double forfeit, and a draw
+// decided by penalties is won on the shoot-out score when the overtime
+// parameter is true.
+func teamWins(side, other, overtime string) string {
+	return "(g.outcome<>'doubleForfeit' AND (g.score_" + side + ">g.score_" + other + " OR " + overtime +
+		" AND g.score_a=g.score_b AND g.decided_in='penalties' AND g.penalties_" + side + ">g.penalties_" + other + "))"
+}
+
+// Find selects the games matching the filter in SQL, reading one game past
+// the limit to tell whether there is a next page.
+func (g *GamesData) Find(filter tournament.GameFilter) (*tournament.GamePage, error) {
+	args := []interface{}{g.competitionID}
+	arg := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	where := "g.competition_id=$1"
+	if filter.Team != "" {
+		team := teamIDsOf(arg(filter.Team))
+		where += " AND (g.team_a_id IN " + team + " OR g.team_b_id IN " + team + ")"
+		if filter.Opponent != "" {
+			opponent := teamIDsOf(arg(filter.Opponent))
+			where += " AND (g.team_a_id IN " + opponent + " OR g.team_b_id IN " + opponent + ")"
+		}
+		if filter.Result != tournament.AnyResult {
+			overtime := arg(filter.Scoring.Overtime)
+			teamAWins, teamBWins := teamWins("a", "b", overtime), teamWins("b", "a", overtime)
+			switch filter.Result {
+			case tournament.WinResult:
+				where += " AND (g.team_a_id IN " + team + " AND " + teamAWins + " OR g.team_b_id IN " + team + " AND " + teamBWins + ")"
+			case tournament.DrawResult:
+				where += " AND g.outcome<>'doubleForfeit' AND NOT " + teamAWins + " AND NOT " + teamBWins
+			case tournament.LossResult:
+				where += " AND (g.outcome='doubleForfeit' OR g.team_a_id IN " + team + " AND " + teamBWins + " OR g.team_b_id IN " + team + " AND " + teamAWins + ")"
+			}
+		}
+	}
+	if !filter.From.IsZero() {
+		where += " AND g.played_at>=" + arg(filter.From)
+	}
+	if !filter.To.IsZero() {
+		where += " AND g.played_at<" + arg(filter.To)
+	}
+
+	column, order, comparison := "g.played_at", "ASC", ">"
+	if filter.SortBy == tournament.SortByRecordedAt {
+		column = "g.recorded_at"
+	}
+	if filter.Descending {
+		order, comparison = "DESC", "<"
+	}
+	if filter.After != nil {
+		where += " AND (" + column + ", g.id)" + comparison + "(" + arg(filter.After.Time) + ", " + arg(filter.After.ID) + ")"
+	}
+
+	rows, err := g.pool.Query(context.Background(),
+		"SELECT "+gameColumns+" FROM "+gamesTable+" WHERE "+where+
+			" ORDER BY "+column+" "+order+", g.id "+order+" LIMIT "+arg(filter.Limit+1),
+		args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	games, err := rowsToGames(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &tournament.GamePage{Games: games}
+	if len(games) > filter.Limit {
+		page.Games = games[:filter.Limit]
+		page.NextCursor = tournament.CursorOf(&page.Games[filter.Limit-1], filter.SortBy).String()
+	}
+	return page, nil
+}
+
+// teamIDsOf returns the subquery of the ID of the team named, or aliased, by
+// the parameter, ignoring case.
+func teamIDsOf(param string) string {
+	return "(SELECT id FROM teams WHERE lower(name)=lower(" + param + ") " +
+		"UNION SELECT team_id FROM team_aliases WHERE lower(alias)=lower(" + param + "))"
+}
+
 func rowsToGames(rows pgx.Rows) ([]tournament.Game, error) {
 	games := []tournament.Game{}
 	for rows.Next() {
-		var teamA, teamB string
-		var scoreA, scoreB int
-		err := rows.Scan(&teamA, &scoreA, &teamB, &scoreB)
+		var id, scoreA, scoreB, penaltiesA, penaltiesB int
+		var teamA, teamB, decidedIn, outcome string
+		var playedAt, recordedAt time.Time
+		err := rows.Scan(&id, &teamA, &scoreA, &teamB, &scoreB, &decidedIn, &penaltiesA, &penaltiesB, &outcome, &playedAt, &recordedAt)
 		if err != nil {
 			return nil, err
 		}
 
-		game := tournament.Game{teamA, scoreA, teamB, scoreB}
+		period, err := tournament.ParsePeriod(decidedIn)
+		if err != nil {
+			return nil, err
+		}
+		gameOutcome, err := tournament.ParseOutcome(outcome)
+		if err != nil {
+			return nil, err
+		}
+
+		game := tournament.Game{
+			ID:         id,
+			TeamA:      teamA,
+			ScoreA:     scoreA,
+			TeamB:      teamB,
+			ScoreB:     scoreB,
+			DecidedIn:  period,
+			PenaltiesA: penaltiesA,
+			PenaltiesB: penaltiesB,
+			Outcome:    gameOutcome,
+			PlayedAt:   playedAt,
+			RecordedAt: recordedAt,
+		}
 		games = append(games, game)
 	}
-	return games, nil
+	return games, rows.Err()
+}
+
+type TeamsData struct {
+	pool *pgxpool.Pool
+}
+
+func NewTeamsData(p *pgxpool.Pool) *TeamsData {
+	return &TeamsData{p}
+}
+
+func (r *TeamsData) Save(team *tournament.Team) error {
+	tx, err := r.pool.Begin(context.Background())
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(context.Background())
+
+	var id int
+	err = tx.QueryRow(context.Background(),
+		"INSERT INTO teams(name, short_code) VALUES ($1, $2) RETURNING id",
+		team.Name, nullIfEmpty(team.ShortCode)).Scan(&id)
+	if err != nil {
+		return teamError(err)
+	}
+	if err := saveAliases(tx, id, team.Aliases); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(context.Background()); err != nil {
+		return err
+	}
+	team.ID = id
+	return nil
+}
+
+// Update replaces the team and its aliases.
+func (r *TeamsData) Update(team *tournament.Team) error {
+	tx, err := r.pool.Begin(context.Background())
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(context.Background())
+
+	if err := updateTeam(tx, team); err != nil {
+		return err
+	}
+	return tx.Commit(context.Background())
+}
+
+// Rename replaces the team and the name it had in the tables naming teams
+// rather than referencing them by ID.
+func (r *TeamsData) Rename(team *tournament.Team, change *tournament.TeamChange) error {
+	tx, err := r.pool.Begin(context.Background())
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(context.Background())
+
+	if err := updateTeam(tx, team); err != nil {
+		return err
+	}
+	if err := replaceTeamNames(tx, []string{change.From}, team.Name); err != nil {
+		return err
+	}
+	if err := recordTeamChange(tx, change); err != nil {
+		return err
+	}
+	return tx.Commit(context.Background())
+}
+
+// Merge moves the games of the team into the other team, replaces all names
+// of the team and removes it.
+func (r *TeamsData) Merge(from, into *tournament.Team, change *tournament.TeamChange) error {
+	tx, err := r.pool.Begin(context.Background())
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(context.Background())
+
+	var played bool
+	err = tx.QueryRow(context.Background(),
+		"SELECT EXISTS (SELECT 1 FROM games WHERE team_a_id=$1 AND team_b_id=$2 OR team_a_id=$2 AND team_b_id=$1)",
+		from.ID, into.ID).Scan(&played)
+	if err != nil {
+		return err
+	}
+	if played {
+		return tournament.ErrTeamsPlayedEachOther
+	}
+
+	for _, sql := range []string{
+		"UPDATE games SET team_a_id=$2 WHERE team_a_id=$1",
+		"UPDATE games SET team_b_id=$2 WHERE team_b_id=$1",
+		"DELETE FROM teams WHERE id=$1",
+	} {
+		if _, err := tx.Exec(context.Background(), sql, from.ID, into.ID); err != nil {
+			return err
+		}
+	}
+	if err := updateTeam(tx, into); err != nil {
+		return err
+	}
+	if err := replaceTeamNames(tx, append([]string{from.Name}, from.Aliases...), into.Name); err != nil {
+		return err
+	}
+	if err := recordTeamChange(tx, change); err != nil {
+		return err
+	}
+	return tx.Commit(context.Background())
+}
+
+func updateTeam(tx pgx.Tx, team *tournament.Team) error {
+	tag, err := tx.Exec(context.Background(),
+		"UPDATE teams SET name=$2, short_code=$3 WHERE id=$1",
+		team.ID, team.Name, nullIfEmpty(team.ShortCode))
+	if err != nil {
+		return teamError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return tournament.ErrTeamNotFound
+	}
+	if _, err := tx.Exec(context.Background(), "DELETE FROM team_aliases WHERE team_id=$1", team.ID); err != nil {
+		return err
+	}
+	return saveAliases(tx, team.ID, team.Aliases)
+}
+
+// teamNameReplacements replace the names $2, in lower case, with the name $1
+// in the tables naming teams. A team entered twice in a competition or group
+// stage is kept once, and the rows of both teams in the final standings are
+// combined.
+var teamNameReplacements = []string{
+	"UPDATE fixtures SET home_team=$1 WHERE lower(home_team)=ANY($2)",
+	"UPDATE fixtures SET away_team=$1 WHERE lower(away_team)=ANY($2)",
+	"UPDATE bracket_matches SET team_a=$1 WHERE lower(team_a)=ANY($2)",
+	"UPDATE bracket_matches SET team_b=$1 WHERE lower(team_b)=ANY($2)",
+	"UPDATE bracket_matches SET winner=$1 WHERE lower(winner)=ANY($2)",
+	"DELETE FROM group_stage_teams f WHERE lower(f.team)=ANY($2) AND EXISTS (" +
+		"SELECT 1 FROM group_stage_teams t WHERE t.group_stage_id=f.group_stage_id AND lower(t.team)=lower($1) AND NOT lower(t.team)=ANY($2))",
+	"UPDATE group_stage_teams SET team=$1 WHERE lower(team)=ANY($2)",
+	"DELETE FROM competition_teams f WHERE lower(f.team)=ANY($2) AND EXISTS (" +
+		"SELECT 1 FROM competition_teams t WHERE t.competition_id=f.competition_id AND lower(t.team)=lower($1) AND NOT lower(t.team)=ANY($2))",
+	"UPDATE competition_teams SET team=$1 WHERE lower(team)=ANY($2)",
+	"UPDATE standings_snapshots t SET played=t.played+f.played, won=t.won+f.won, drawn=t.drawn+f.drawn, lost=t.lost+f.lost, " +
+		"goals_for=t.goals_for+f.goals_for, goals_against=t.goals_against+f.goals_against, " +
+		"goal_difference=t.goal_difference+f.goal_difference, points=t.points+f.points, adjustment=t.adjustment+f.adjustment, " +
+		"awarded_goals_for=t.awarded_goals_for+f.awarded_goals_for, awarded_goals_against=t.awarded_goals_against+f.awarded_goals_against, " +
+		"regulation_won=t.regulation_won+f.regulation_won, overtime_won=t.overtime_won+f.overtime_won, overtime_lost=t.overtime_lost+f.overtime_lost " +
+		"FROM standings_snapshots f WHERE f.competition_id=t.competition_id AND lower(f.team)=ANY($2) " +
+		"AND lower(t.team)=lower($1) AND NOT lower(t.team)=ANY($2)",
+	"DELETE FROM standings_snapshots f WHERE lower(f.team)=ANY($2) AND EXISTS (" +
+		"SELECT 1 FROM standings_snapshots t WHERE t.competition_id=f.competition_id AND lower(t.team)=lower($1) AND NOT lower(t.team)=ANY($2))",
+	"UPDATE standings_snapshots SET team=$1 WHERE lower(team)=ANY($2)",
+	"UPDATE point_adjustments SET team=$1 WHERE lower(team)=ANY($2)",
+	"UPDATE standings_leaders SET team=$1 WHERE lower(team)=ANY($2)",
+}
+
+func replaceTeamNames(tx pgx.Tx, names []string, to string) error {
+	lowerNames := make([]string, 0, len(names))
+	for _, name := range names {
+		lowerNames = append(lowerNames, strings.ToLower(name))
+	}
+	for _, sql := range teamNameReplacements {
+		if _, err := tx.Exec(context.Background(), sql, to, lowerNames); err != nil {
+			return err
+		}
+	}
+	return renumberStandingsSnapshots(tx, to)
+}
+
+// renumberStandingsSnapshots closes the gaps left in the final standings the
+// team is in by the rows combined into it, keeping the teams in their order.
+// The positions are negated first so that no two rows share a position.
+func renumberStandingsSnapshots(tx pgx.Tx, team string) error {
+	if _, err := tx.Exec(context.Background(),
+		"UPDATE standings_snapshots SET position=-1-position "+
+			"WHERE competition_id IN (SELECT competition_id FROM standings_snapshots WHERE lower(team)=lower($1))",
+		team); err != nil {
+		return err
+	}
+	_, err := tx.Exec(context.Background(),
+		"UPDATE standings_snapshots s SET position=r.position FROM ("+
+			"SELECT competition_id, position AS negated, row_number() OVER (PARTITION BY competition_id ORDER BY position DESC)-1 AS position "+
+			"FROM standings_snapshots WHERE position<0) r "+
+			"WHERE s.competition_id=r.competition_id AND s.position=r.negated")
+	return err
+}
+
+func recordTeamChange(tx pgx.Tx, change *tournament.TeamChange) error {
+	return tx.QueryRow(context.Background(),
+		"INSERT INTO team_changes(team_id, action, from_name, to_name, principal, request_id) "+
+			"VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, at",
+		change.TeamID, string(change.Action), change.From, change.To, change.Principal, change.RequestID).Scan(&change.ID, &change.At)
+}
+
+func (r *TeamsData) FindChanges(teamID int) ([]tournament.TeamChange, error) {
+	rows, err := r.pool.Query(context.Background(),
+		"SELECT id, team_id, action, from_name, to_name, principal, request_id, at "+
+			"FROM team_changes WHERE team_id=$1 ORDER BY id",
+		teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := []tournament.TeamChange{}
+	for rows.Next() {
+		var change tournament.TeamChange
+		var action string
+		err := rows.Scan(&change.ID, &change.TeamID, &action, &change.From, &change.To, &change.Principal, &change.RequestID, &change.At)
+		if err != nil {
+			return nil, err
+		}
+		change.Action = tournament.TeamAction(action)
+		changes = append(changes, change)
+	}
+	return changes, rows.Err()
+}
+
+func saveAliases(tx pgx.Tx, teamID int, aliases []string) error {
+	for _, alias := range aliases {
+		_, err := tx.Exec(context.Background(),
+			"INSERT INTO team_aliases(team_id, alias) VALUES ($1, $2)",
+			teamID, alias)
+		if err != nil {
+			return teamError(err)
+		}
+	}
+	return nil
+}
+
+func (r *TeamsData) Delete(id int) error {
+	tag, err := r.pool.Exec(context.Background(), "DELETE FROM teams WHERE id=$1", id)
+	if err != nil {
+		return teamError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return tournament.ErrTeamNotFound
+	}
+	return nil
+}
+
+func (r *TeamsData) FindByID(id int) (*tournament.Team, error) {
+	return r.findOne("WHERE t.id=$1", id)
+}
+
+func (r *TeamsData) FindByName(name string) (*tournament.Team, error) {
+	return r.findOne("WHERE t.id IN (SELECT id FROM teams WHERE lower(name)=lower($1) "+
+		"UNION SELECT team_id FROM team_aliases WHERE lower(alias)=lower($1))", name)
+}
+
+func (r *TeamsData) FindAll() ([]tournament.Team, error) {
+	return r.find("")
+}
+
+func (r *TeamsData) findOne(where string, args ...interface{}) (*tournament.Team, error) {
+	teams, err := r.find(where, args...)
+	if err != nil {
+		return nil, err
+	}
+	if len(teams) == 0 {
+		return nil, tournament.ErrTeamNotFound
+	}
+	return &teams[0], nil
+}
+
+func (r *TeamsData) find(where string, args ...interface{}) ([]tournament.Team, error) {
+	rows, err := r.pool.Query(context.Background(),
+		"SELECT t.id, t.name, t.short_code, a.alias "+
+			"FROM teams t LEFT JOIN team_aliases a ON a.team_id = t.id "+where+" ORDER BY t.id, a.alias",
+		args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	teams := []tournament.Team{}
+	for rows.Next() {
+		var team tournament.Team
+		var shortCode, alias *string
+		if err := rows.Scan(&team.ID, &team.Name, &shortCode, &alias); err != nil {
+			return nil, err
+		}
+
+		if len(teams) == 0 || teams[len(teams)-1].ID != team.ID {
+			team.ShortCode = emptyIfNull(shortCode)
+			teams = append(teams, team)
+		}
+		if alias != nil {
+			last := &teams[len(teams)-1]
+			last.Aliases = append(last.Aliases, *alias)
+		}
+	}
+	return teams, rows.Err()
+}
+
+// PostgreSQL error codes of constraint violations
+const (
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
+)
+
+// teamError translates the violations of the team constraints.
+func teamError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case uniqueViolation:
+			return tournament.ErrTeamExists
+		case foreignKeyViolation:
+			return tournament.ErrTeamInUse
+		}
+	}
+	return err
+}
+
+type FixturesData struct {
+	pool          *pgxpool.Pool
+	competitionID int
+}
+
+// NewFixturesData returns the repository of the default competition.
+func NewFixturesData(p *pgxpool.Pool) *FixturesData {
+	return &FixturesData{p, tournament.DefaultCompetitionID}
+}
+
+// ForCompetition returns the repository of the competition.
+func (r *FixturesData) ForCompetition(competitionID int) *FixturesData {
+	return &FixturesData{r.pool, competitionID}
+}
+
+func (f *FixturesData) Save(fixtures []tournament.Fixture) error {
+	tx, err := f.pool.Begin(context.Background())
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(context.Background())
+
+	ids := make([]int, len(fixtures))
+	for i, fixture := range fixtures {
+		err := tx.QueryRow(context.Background(),
+			"INSERT INTO fixtures(competition_id, round, home_team, away_team, status, game_id) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id",
+			f.competitionID, fixture.Round, fixture.HomeTeam, nullIfEmpty(fixture.AwayTeam), fixture.Status.String(), nullIfZero(fixture.GameID)).Scan(&ids[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(context.Background()); err != nil {
+		return err
+	}
+	for i := range fixtures {
+		fixtures[i].ID = ids[i]
+	}
+	return nil
+}
+
+func (f *FixturesData) Update(fixture *tournament.Fixture) error {
+	tx, err := f.pool.Begin(context.Background())
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(context.Background())
+
+	if err := updateFixture(tx, f.competitionID, fixture); err != nil {
+		return err
+	}
+	return tx.Commit(context.Background())
+}
+
+// updateFixture updates the fixture in the transaction, telling about it in
+// the outbox.
+func updateFixture(tx pgx.Tx, competitionID int, fixture *tournament.Fixture) error {
+	tag, err := tx.Exec(context.Background(),
+		"UPDATE fixtures SET status=$3, game_id=$4 WHERE competition_id=$1 AND id=$2",
+		competitionID, fixture.ID, fixture.Status.String(), nullIfZero(fixture.GameID))
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return tournament.ErrFixtureNotFound
+	}
+	return saveOutboxEvent(tx, &tournament.OutboxEvent{CompetitionID: competitionID, Type: tournament.FixtureUpdated, Fixture: fixture})
+}
+
+func (f *FixturesData) FindByID(id int) (*tournament.Fixture, error) {
+	fixtures, err := f.find("AND id=$2", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(fixtures) == 0 {
+		return nil, tournament.ErrFixtureNotFound
+	}
+	return &fixtures[0], nil
+}
+
+func (f *FixturesData) FindAll() ([]tournament.Fixture, error) {
+	return f.find("")
+}
+
+func (f *FixturesData) find(where string, args ...interface{}) ([]tournament.Fixture, error) {
+	rows, err := f.pool.Query(context.Background(),
+		"SELECT id, round, home_team, away_team, status, game_id FROM fixtures WHERE competition_id=$1 "+where+" ORDER BY round, id",
+		append([]interface{}{f.competitionID}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	fixtures := []tournament.Fixture{}
+	for rows.Next() {
+		var id, round int
+		var homeTeam, status string
+		var awayTeam *string
+		var gameID *int
+		if err := rows.Scan(&id, &round, &homeTeam, &awayTeam, &status, &gameID); err != nil {
+			return nil, err
+		}
+
+		fixtureStatus, err := tournament.ParseFixtureStatus(status)
+		if err != nil {
+			return nil, err
+		}
+		fixture := tournament.Fixture{
+			ID:       id,
+			Round:    round,
+			HomeTeam: homeTeam,
+			AwayTeam: emptyIfNull(awayTeam),
+			Status:   fixtureStatus,
+			GameID:   zeroIfNull(gameID),
+		}
+		fixtures = append(fixtures, fixture)
+	}
+	return fixtures, rows.Err()
+}
+
+type BracketsData struct {
+	pool          *pgxpool.Pool
+	competitionID int
+}
+
+// NewBracketsData returns the repository of the default competition.
+func NewBracketsData(p *pgxpool.Pool) *BracketsData {
+	return &BracketsData{p, tournament.DefaultCompetitionID}
+}
+
+// ForCompetition returns the repository of the competition.
+func (r *BracketsData) ForCompetition(competitionID int) *BracketsData {
+	return &BracketsData{r.pool, competitionID}
+}
+
+func (b *BracketsData) Save(bracket *tournament.Bracket) error {
+	tx, err := b.pool.Begin(context.Background())
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(context.Background())
+
+	var id int
+	err = tx.QueryRow(context.Background(), "INSERT INTO brackets(competition_id, name) VALUES ($1, $2) RETURNING id", b.competitionID, bracket.Name).Scan(&id)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range bracket.Matches {
+		_, err := tx.Exec(context.Background(),
+			"INSERT INTO bracket_matches(bracket_id, round, position, team_a, team_b, winner) VALUES ($1, $2, $3, $4, $5, $6)",
+			id, m.Round, m.Position, nullIfEmpty(m.TeamA), nullIfEmpty(m.TeamB), nullIfEmpty(m.Winner))
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(context.Background()); err != nil {
+		return err
+	}
+	bracket.ID = id
+	return nil
+}
+
+func (b *BracketsData) Update(bracket *tournament.Bracket) error {
+	tx, err := b.pool.Begin(context.Background())
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(context.Background())
+
+	for _, m := range bracket.Matches {
+		_, err := tx.Exec(context.Background(),
+			"UPDATE bracket_matches SET team_a=$5, team_b=$6, winner=$7 WHERE bracket_id=$2 AND round=$3 AND position=$4 "+
+				"AND bracket_id IN (SELECT id FROM brackets WHERE competition_id=$1)",
+			b.competitionID, bracket.ID, m.Round, m.Position, nullIfEmpty(m.TeamA), nullIfEmpty(m.TeamB), nullIfEmpty(m.Winner))
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(context.Background())
+}
+
+func (b *BracketsData) FindByID(id int) (*tournament.Bracket, error) {
+	brackets, err := b.find("AND b.id=$2", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(brackets) == 0 {
+		return nil, tournament.ErrBracketNotFound
+	}
+	return &brackets[0], nil
+}
+
+func (b *BracketsData) FindAll() ([]tournament.Bracket, error) {
+	return b.find("")
+}
+
+func (b *BracketsData) find(where string, args ...interface{}) ([]tournament.Bracket, error) {
+	rows, err := b.pool.Query(context.Background(),
+		"SELECT b.id, b.name, m.round, m.position, m.team_a, m.team_b, m.winner "+
+			"FROM brackets b JOIN bracket_matches m ON m.bracket_id = b.id WHERE b.competition_id=$1 "+where+
+			" ORDER BY b.id, m.round, m.position",
+		append([]interface{}{b.competitionID}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	brackets := []tournament.Bracket{}
+	for rows.Next() {
+		var id, round, position int
+		var name string
+		var teamA, teamB, winner *string
+		if err := rows.Scan(&id, &name, &round, &position, &teamA, &teamB, &winner); err != nil {
+			return nil, err
+		}
+
+		if len(brackets) == 0 || brackets[len(brackets)-1].ID != id {
+			brackets = append(brackets, tournament.Bracket{ID: id, Name: name})
+		}
+		bracket := &brackets[len(brackets)-1]
+		bracket.Matches = append(bracket.Matches, tournament.BracketMatch{
+			Round:    round,
+			Position: position,
+			TeamA:    emptyIfNull(teamA),
+			TeamB:    emptyIfNull(teamB),
+			Winner:   emptyIfNull(winner),
+		})
+	}
+	return brackets, rows.Err()
+}
+
+type GroupStagesData struct {
+	pool          *pgxpool.Pool
+	competitionID int
+}
+
+// NewGroupStagesData returns the repository of the default competition.
+func NewGroupStagesData(p *pgxpool.Pool) *GroupStagesData {
+	return &GroupStagesData{p, tournament.DefaultCompetitionID}
+}
+
+// ForCompetition returns the repository of the competition.
+func (r *GroupStagesData) ForCompetition(competitionID int) *GroupStagesData {
+	return &GroupStagesData{r.pool, competitionID}
+}
+
+func (g *GroupStagesData) Save(stage *tournament.GroupStage) error {
+	tx, err := g.pool.Begin(context.Background())
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(context.Background())
+
+	var id int
+	err = tx.QueryRow(context.Background(),
+		"INSERT INTO group_stages(competition_id, name, qualifiers, bracket_id) VALUES ($1, $2, $3, $4) RETURNING id",
+		g.competitionID, stage.Name, stage.Qualifiers, nullIfZero(stage.BracketID)).Scan(&id)
+	if err != nil {
+		return err
+	}
+
+	for i, group := range stage.Groups {
+		for position, team := range group.Teams {
+			_, err := tx.Exec(context.Background(),
+				"INSERT INTO group_stage_teams(group_stage_id, group_index, group_name, position, team) VALUES ($1, $2, $3, $4, $5)",
+				id, i, group.Name, position, team)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := tx.Commit(context.Background()); err != nil {
+		return err
+	}
+	stage.ID = id
+	return nil
+}
+
+func (g *GroupStagesData) Update(stage *tournament.GroupStage) error {
+	_, err := g.pool.Exec(context.Background(), "UPDATE group_stages SET name=$3, qualifiers=$4, bracket_id=$5 WHERE competition_id=$1 AND id=$2",
+		g.competitionID, stage.ID, stage.Name, stage.Qualifiers, nullIfZero(stage.BracketID))
+	return err
+}
+
+func (g *GroupStagesData) FindByID(id int) (*tournament.GroupStage, error) {
+	stages, err := g.find("AND s.id=$2", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(stages) == 0 {
+		return nil, tournament.ErrGroupStageNotFound
+	}
+	return &stages[0], nil
+}
+
+func (g *GroupStagesData) FindAll() ([]tournament.GroupStage, error) {
+	return g.find("")
+}
+
+func (g *GroupStagesData) find(where string, args ...interface{}) ([]tournament.GroupStage, error) {
+	rows, err := g.pool.Query(context.Background(),
+		"SELECT s.id, s.name, s.qualifiers, s.bracket_id, t.group_index, t.group_name, t.team "+
+			"FROM group_stages s JOIN group_stage_teams t ON t.group_stage_id = s.id WHERE s.competition_id=$1 "+where+
+			" ORDER BY s.id, t.group_index, t.position",
+		append([]interface{}{g.competitionID}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stages := []tournament.GroupStage{}
+	for rows.Next() {
+		var id, qualifiers, groupIndex int
+		var bracketID *int
+		var name, groupName, team string
+		if err := rows.Scan(&id, &name, &qualifiers, &bracketID, &groupIndex, &groupName, &team); err != nil {
+			return nil, err
+		}
+
+		if len(stages) == 0 || stages[len(stages)-1].ID != id {
+			stages = append(stages, tournament.GroupStage{ID: id, Name: name, Qualifiers: qualifiers, BracketID: zeroIfNull(bracketID)})
+		}
+		stage := &stages[len(stages)-1]
+		if len(stage.Groups) <= groupIndex {
+			stage.Groups = append(stage.Groups, tournament.Group{Name: groupName})
+		}
+		stage.Groups[groupIndex].Teams = append(stage.Groups[groupIndex].Teams, team)
+	}
+	return stages, rows.Err()
+}
+
+type CompetitionsData struct {
+	pool *pgxpool.Pool
+}
+
+func NewCompetitionsData(p *pgxpool.Pool) *CompetitionsData {
+	return &CompetitionsData{p}
+}
+
+func (c *CompetitionsData) Save(competition *tournament.Competition) error {
+	tx, err := c.pool.Begin(context.Background())
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(context.Background())
+
+	var id int
+	err = tx.QueryRow(context.Background(),
+		"INSERT INTO competitions(name, season, format, win_points, draw_points, loss_points, big_win_margin, big_win_bonus, forfeit_deduction, "+
+			"overtime, overtime_win_points, overtime_loss_points, closed, "+
+			"promotion_places, promotion_playoff_places, relegation_playoff_places, relegation_places) "+
+			"VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17) RETURNING id",
+		competition.Name, competition.Season, string(competition.Format),
+		competition.Scoring.Win, competition.Scoring.Draw, competition.Scoring.Loss,
+		competition.Scoring.BigWinMargin, competition.Scoring.BigWinBonus, competition.Scoring.ForfeitDeduction,
+		competition.Scoring.Overtime, competition.Scoring.OvertimeWin, competition.Scoring.OvertimeLoss, competition.Closed,
+		competition.Zones.Promotion, competition.Zones.PromotionPlayoff, competition.Zones.RelegationPlayoff, competition.Zones.Relegation).Scan(&id)
+	if err != nil {
+		return err
+	}
+
+	for position, team := range competition.Teams {
+		_, err := tx.Exec(context.Background(),
+			"INSERT INTO competition_teams(competition_id, position, team) VALUES ($1, $2, $3)",
+			id, position, team)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(context.Background()); err != nil {
+		return err
+	}
+	competition.ID = id
+	return nil
+}
+
+func (c *CompetitionsData) FindByID(id int) (*tournament.Competition, error) {
+	competitions, err := c.find("WHERE c.id=$1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(competitions) == 0 {
+		return nil, tournament.ErrCompetitionNotFound
+	}
+	return &competitions[0], nil
+}
+
+func (c *CompetitionsData) FindAll() ([]tournament.Competition, error) {
+	return c.find("")
+}
+
+func (c *CompetitionsData) find(where string, args ...interface{}) ([]tournament.Competition, error) {
+	rows, err := c.pool.Query(context.Background(),
+		"SELECT c.id, c.name, c.season, c.format, c.win_points, c.draw_points, c.loss_points, c.big_win_margin, c.big_win_bonus, c.forfeit_deduction, "+
+			"c.overtime, c.overtime_win_points, c.overtime_loss_points, c.closed, "+
+			"c.promotion_places, c.promotion_playoff_places, c.relegation_playoff_places, c.relegation_places, t.team "+
+			"FROM competitions c LEFT JOIN competition_teams t ON t.competition_id = c.id "+where+" ORDER BY c.id, t.position",
+		args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	competitions := []tournament.Competition{}
+	for rows.Next() {
+		var competition tournament.Competition
+		var format string
+		var team *string
+		err := rows.Scan(&competition.ID, &competition.Name, &competition.Season, &format,
+			&competition.Scoring.Win, &competition.Scoring.Draw, &competition.Scoring.Loss,
+			&competition.Scoring.BigWinMargin, &competition.Scoring.BigWinBonus, &competition.Scoring.ForfeitDeduction,
+			&competition.Scoring.Overtime, &competition.Scoring.OvertimeWin, &competition.Scoring.OvertimeLoss, &competition.Closed,
+			&competition.Zones.Promotion, &competition.Zones.PromotionPlayoff, &competition.Zones.RelegationPlayoff, &competition.Zones.Relegation, &team)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(competitions) == 0 || competitions[len(competitions)-1].ID != competition.ID {
+			competition.Format = tournament.Format(format)
+			competitions = append(competitions, competition)
+		}
+		if team != nil {
+			last := &competitions[len(competitions)-1]
+			last.Teams = append(last.Teams, *team)
+		}
+	}
+	return competitions, rows.Err()
+}
+
+// UpdateScoring replaces the scoring rules of the competition.
+func (c *CompetitionsData) UpdateScoring(id int, rules tournament.ScoringRules) error {
+	tag, err := c.pool.Exec(context.Background(),
+		"UPDATE competitions SET win_points=$2, draw_points=$3, loss_points=$4, big_win_margin=$5, big_win_bonus=$6, forfeit_deduction=$7, "+
+			"overtime=$8, overtime_win_points=$9, overtime_loss_points=$10 WHERE id=$1",
+		id, rules.Win, rules.Draw, rules.Loss, rules.BigWinMargin, rules.BigWinBonus, rules.ForfeitDeduction,
+		rules.Overtime, rules.OvertimeWin, rules.OvertimeLoss)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return tournament.ErrCompetitionNotFound
+	}
+	return nil
+}
+
+// Close marks the competition as closed and stores a snapshot of its final
+// standings, in a single transaction.
+func (c *CompetitionsData) Close(id int, standings []tournament.Stats) error {
+	tx, err := c.pool.Begin(context.Background())
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(context.Background())
+
+	tag, err := tx.Exec(context.Background(), "UPDATE competitions SET closed=true WHERE id=$1 AND NOT closed", id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return tournament.ErrSeasonClosed
+	}
+
+	for position, s := range standings {
+		_, err := tx.Exec(context.Background(),
+			"INSERT INTO standings_snapshots(competition_id, position, team, played, won, drawn, lost, goals_for, goals_against, goal_difference, points, "+
+				"adjustment, awarded_goals_for, awarded_goals_against, regulation_won, overtime_won, overtime_lost) "+
+				"VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)",
+			id, position, s.Team, s.Played, s.Won, s.Drawn, s.Lost, s.GoalsFor, s.GoalsAgainst, s.GoalDifference, s.Points,
+			s.Adjustment, s.AwardedGoalsFor, s.AwardedGoalsAgainst, s.RegulationWon, s.OvertimeWon, s.OvertimeLost)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(context.Background())
+}
+
+func (c *CompetitionsData) FindStandings(id int) ([]tournament.Stats, error) {
+	rows, err := c.pool.Query(context.Background(),
+		"SELECT team, played, won, drawn, lost, goals_for, goals_against, goal_difference, points, "+
+			"adjustment, awarded_goals_for, awarded_goals_against, regulation_won, overtime_won, overtime_lost FROM standings_snapshots WHERE competition_id=$1 ORDER BY position",
+		id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	standings := []tournament.Stats{}
+	for rows.Next() {
+		var s tournament.Stats
+		err := rows.Scan(&s.Team, &s.Played, &s.Won, &s.Drawn, &s.Lost, &s.GoalsFor, &s.GoalsAgainst, &s.GoalDifference, &s.Points,
+			&s.Adjustment, &s.AwardedGoalsFor, &s.AwardedGoalsAgainst, &s.RegulationWon, &s.OvertimeWon, &s.OvertimeLost)
+		if err != nil {
+			return nil, err
+		}
+		standings = append(standings, s)
+	}
+	return standings, rows.Err()
+}
+
+type AuditData struct {
+	pool          *pgxpool.Pool
+	competitionID int
+}
+
+// NewAuditData returns the audit log of the default competition.
+func NewAuditData(p *pgxpool.Pool) *AuditData {
+	return &AuditData{p, tournament.DefaultCompetitionID}
+}
+
+// ForCompetition returns the audit log of the competition.
+func (r *AuditData) ForCompetition(competitionID int) *AuditData {
+	return &AuditData{r.pool, competitionID}
+}
+
+func (a *AuditData) Record(entry *tournament.AuditEntry) error {
+	return recordAuditEntry(a.pool, a.competitionID, entry)
+}
+
+// rowQuerier is a pool or a transaction.
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+func recordAuditEntry(q rowQuerier, competitionID int, entry *tournament.AuditEntry) error {
+	before, err := gameJSON(entry.Before)
+	if err != nil {
+		return err
+	}
+	after, err := gameJSON(entry.After)
+	if err != nil {
+		return err
+	}
+
+	return q.QueryRow(context.Background(),
+		"INSERT INTO audit_log(competition_id, game_id, action, principal, request_id, before, after) "+
+			"VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, at",
+		competitionID, entry.GameID, string(entry.Action), entry.Principal, entry.RequestID, before, after).Scan(&entry.ID, &entry.At)
+}
+
+// recordGameChange records the audit entry, when set, of the change of the
+// game with the values it has after the change.
+func recordGameChange(tx pgx.Tx, competitionID int, entry *tournament.AuditEntry, gameID int, after *tournament.Game) error {
+	if entry == nil {
+		return nil
+	}
+	entry.GameID = gameID
+	if after != nil {
+		values := *after
+		entry.After = &values
+	}
+	return recordAuditEntry(tx, competitionID, entry)
+}
+
+func (a *AuditData) FindByGame(gameID int) ([]tournament.AuditEntry, error) {
+	return a.find("AND game_id=$2 ORDER BY id", gameID)
+}
+
+func (a *AuditData) Find(filter tournament.AuditFilter) ([]tournament.AuditEntry, error) {
+	return a.find("AND ($2='' OR principal=$2) AND ($3=0 OR id<$3) ORDER BY id DESC LIMIT $4",
+		filter.Principal, filter.BeforeID, filter.Limit)
+}
+
+func (a *AuditData) find(where string, args ...interface{}) ([]tournament.AuditEntry, error) {
+	rows, err := a.pool.Query(context.Background(),
+		"SELECT id, game_id, action, principal, request_id, at, before, after FROM audit_log WHERE competition_id=$1 "+where,
+		append([]interface{}{a.competitionID}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []tournament.AuditEntry{}
+	for rows.Next() {
+		var entry tournament.AuditEntry
+		var action string
+		var before, after []byte
+		err := rows.Scan(&entry.ID, &entry.GameID, &action, &entry.Principal, &entry.RequestID, &entry.At, &before, &after)
+		if err != nil {
+			return nil, err
+		}
+		entry.Action = tournament.AuditAction(action)
+		if entry.Before, err = gameFromJSON(before); err != nil {
+			return nil, err
+		}
+		if entry.After, err = gameFromJSON(after); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+func gameJSON(game *tournament.Game) (*string, error) {
+	if game == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(game)
+	if err != nil {
+		return nil, err
+	}
+	s := string(b)
+	return &s, nil
+}
+
+func gameFromJSON(b []byte) (*tournament.Game, error) {
+	if b == nil {
+		return nil, nil
+	}
+	var game tournament.Game
+	if err := json.Unmarshal(b, &game); err != nil {
+		return nil, err
+	}
+	return &game, nil
+}
+
+type PointAdjustmentsData struct {
+	pool          *pgxpool.Pool
+	competitionID int
+}
+
+// NewPointAdjustmentsData returns the repository of the default competition.
+func NewPointAdjustmentsData(p *pgxpool.Pool) *PointAdjustmentsData {
+	return &PointAdjustmentsData{p, tournament.DefaultCompetitionID}
+}
+
+// ForCompetition returns the repository of the competition.
+func (r *PointAdjustmentsData) ForCompetition(competitionID int) *PointAdjustmentsData {
+	return &PointAdjustmentsData{r.pool, competitionID}
+}
+
+func (a *PointAdjustmentsData) Save(adjustment *tournament.PointAdjustment) error {
+	tx, err := a.pool.Begin(context.Background())
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(context.Background())
+
+	err = tx.QueryRow(context.Background(),
+		"INSERT INTO point_adjustments(competition_id, team, delta, reason, principal, request_id) "+
+			"VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, at",
+		a.competitionID, adjustment.Team, adjustment.Delta, adjustment.Reason, adjustment.Principal, adjustment.RequestID).Scan(&adjustment.ID, &adjustment.At)
+	if err != nil {
+		return err
+	}
+	if err := saveOutboxEvent(tx, &tournament.OutboxEvent{CompetitionID: a.competitionID, Type: tournament.PointsAdjusted, Adjustment: adjustment}); err != nil {
+		return err
+	}
+	return tx.Commit(context.Background())
+}
+
+func (a *PointAdjustmentsData) FindByTeam(team string) ([]tournament.PointAdjustment, error) {
+	return a.find("AND lower(team)=lower($2)", team)
+}
+
+func (a *PointAdjustmentsData) FindAll() ([]tournament.PointAdjustment, error) {
+	return a.find("")
+}
+
+func (a *PointAdjustmentsData) find(where string, args ...interface{}) ([]tournament.PointAdjustment, error) {
+	rows, err := a.pool.Query(context.Background(),
+		"SELECT id, team, delta, reason, principal, request_id, at FROM point_adjustments WHERE competition_id=$1 "+where+" ORDER BY id",
+		append([]interface{}{a.competitionID}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	adjustments := []tournament.PointAdjustment{}
+	for rows.Next() {
+		var adjustment tournament.PointAdjustment
+		err := rows.Scan(&adjustment.ID, &adjustment.Team, &adjustment.Delta, &adjustment.Reason,
+			&adjustment.Principal, &adjustment.RequestID, &adjustment.At)
+		if err != nil {
+			return nil, err
+		}
+		adjustments = append(adjustments, adjustment)
+	}
+	return adjustments, rows.Err()
+}
+
+// outboxPayload is the part of the outbox events stored as JSON.
+type outboxPayload struct {
+	Game       *tournament.Game            `json:",omitempty"`
+	Fixture    *tournament.Fixture         `json:",omitempty"`
+	Adjustment *tournament.PointAdjustment `json:",omitempty"`
+	Leader     *tournament.LeaderChange    `json:",omitempty"`
+}
+
+// saveOutboxEvent stores the event in the transaction of the change it tells
+// about, setting its ID and time, and notifies the change feeds of its ID
+// once the transaction commits.
+func saveOutboxEvent(tx pgx.Tx, event *tournament.OutboxEvent) error {
+	payload, err := json.Marshal(&outboxPayload{Game: event.Game, Fixture: event.Fixture, Adjustment: event.Adjustment, Leader: event.Leader})
+	if err != nil {
+		return err
+	}
+	err = tx.QueryRow(context.Background(),
+		"INSERT INTO outbox(competition_id, event_type, payload, origin) VALUES ($1, $2, $3, $4) RETURNING id, created_at",
+		event.CompetitionID, string(event.Type), string(payload), origin).Scan(&event.ID, &event.At)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(context.Background(), "SELECT pg_notify($1, $2)", OutboxChannel, strconv.FormatInt(event.ID, 10))
+	return err
+}
+
+const outboxColumns = "o.id, o.competition_id, o.event_type, o.payload, o.created_at"
+
+// decodeOutboxEvent sets the type and the payload of the event.
+func decodeOutboxEvent(event *tournament.OutboxEvent, eventType string, payload []byte) error {
+	event.Type = tournament.EventType(eventType)
+	var p outboxPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return err
+	}
+	event.Game, event.Fixture, event.Adjustment, event.Leader = p.Game, p.Fixture, p.Adjustment, p.Leader
+	return nil
+}
+
+func rowsToOutboxEvents(rows pgx.Rows) ([]tournament.OutboxEvent, error) {
+	events := []tournament.OutboxEvent{}
+	for rows.Next() {
+		var event tournament.OutboxEvent
+		var eventType string
+		var payload []byte
+		if err := rows.Scan(&event.ID, &event.CompetitionID, &eventType, &payload, &event.At); err != nil {
+			return nil, err
+		}
+		if err := decodeOutboxEvent(&event, eventType, payload); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+// WebhooksData stores the webhooks of all competitions and hands the outbox
+// events over to their deliveries.
+type WebhooksData struct {
+	pool *pgxpool.Pool
+}
+
+func NewWebhooksData(p *pgxpool.Pool) *WebhooksData {
+	return &WebhooksData{p}
+}
+
+func (w *WebhooksData) Save(webhook *tournament.Webhook) error {
+	return w.pool.QueryRow(context.Background(),
+		"INSERT INTO webhooks(url, events, secret, active) VALUES ($1, $2, $3, $4) RETURNING id, created_at",
+		webhook.URL, eventTypeNames(webhook.Events), webhook.Secret, webhook.Active).Scan(&webhook.ID, &webhook.CreatedAt)
+}
+
+func (w *WebhooksData) Update(webhook *tournament.Webhook) error {
+	tag, err := w.pool.Exec(context.Background(),
+		"UPDATE webhooks SET url=$2, events=$3, secret=$4, active=$5 WHERE id=$1",
+		webhook.ID, webhook.URL, eventTypeNames(webhook.Events), webhook.Secret, webhook.Active)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return tournament.ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (w *WebhooksData) Delete(id int) error {
+	tag, err := w.pool.Exec(context.Background(), "DELETE FROM webhooks WHERE id=$1", id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return tournament.ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (w *WebhooksData) FindByID(id int) (*tournament.Webhook, error) {
+	webhooks, err := w.find("WHERE id=$1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(webhooks) == 0 {
+		return nil, tournament.ErrWebhookNotFound
+	}
+	return &webhooks[0], nil
+}
+
+func (w *WebhooksData) FindAll() ([]tournament.Webhook, error) {
+	return w.find("")
+}
+
+func (w *WebhooksData) find(where string, args ...interface{}) ([]tournament.Webhook, error) {
+	rows, err := w.pool.Query(context.Background(),
+		"SELECT id, url, events, secret, active, created_at FROM webhooks "+where+" ORDER BY id", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	webhooks := []tournament.Webhook{}
+	for rows.Next() {
+		var webhook tournament.Webhook
+		var events []string
+		err := rows.Scan(&webhook.ID, &webhook.URL, &events, &webhook.Secret, &webhook.Active, &webhook.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range events {
+			webhook.Events = append(webhook.Events, tournament.EventType(e))
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	return webhooks, rows.Err()
+}
+
+func eventTypeNames(events []tournament.EventType) []string {
+	names := make([]string, 0, len(events))
+	for _, e := range events {
+		names = append(names, string(e))
+	}
+	return names
+}
+
+const deliveryColumns = "d.id, d.webhook_id, d.status, d.attempts, d.next_attempt_at, d.created_at, " + outboxColumns
+
+func (w *WebhooksData) FindDeliveries(webhookID int, filter tournament.DeliveryFilter) ([]tournament.WebhookDelivery, error) {
+	return w.findDeliveries("d.webhook_id=$1 AND ($2='' OR d.status=$2) ORDER BY d.id DESC LIMIT $3",
+		webhookID, string(filter.Status), filter.Limit)
+}
+
+func (w *WebhooksData) FindDelivery(id int) (*tournament.WebhookDelivery, error) {
+	deliveries, err := w.findDeliveries("d.id=$1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(deliveries) == 0 {
+		return nil, tournament.ErrDeliveryNotFound
+	}
+	return &deliveries[0], nil
+}
+
+// findDeliveries returns the deliveries along with the log of their attempts.
+func (w *WebhooksData) findDeliveries(where string, args ...interface{}) ([]tournament.WebhookDelivery, error) {
+	rows, err := w.pool.Query(context.Background(),
+		"SELECT "+deliveryColumns+" FROM webhook_deliveries d JOIN outbox o ON o.id = d.outbox_id WHERE "+where, args...)
+	if err != nil {
+		return nil, err
+	}
+	deliveries, err := rowsToDeliveries(rows, false)
+	rows.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(deliveries))
+	byID := map[int]*tournament.WebhookDelivery{}
+	for i := range deliveries {
+		ids = append(ids, deliveries[i].ID)
+		byID[deliveries[i].ID] = &deliveries[i]
+	}
+	rows, err = w.pool.Query(context.Background(),
+		"SELECT delivery_id, attempt, status_code, error, duration_ms, at FROM webhook_delivery_attempts "+
+			"WHERE delivery_id=ANY($1) ORDER BY delivery_id, attempt", ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var deliveryID, durationMs int
+		var statusCode *int
+		var attemptError *string
+		var attempt tournament.DeliveryAttempt
+		err := rows.Scan(&deliveryID, &attempt.Attempt, &statusCode, &attemptError, &durationMs, &attempt.At)
+		if err != nil {
+			return nil, err
+		}
+		attempt.StatusCode = zeroIfNull(statusCode)
+		attempt.Error = emptyIfNull(attemptError)
+		attempt.Duration = time.Duration(durationMs) * time.Millisecond
+		byID[deliveryID].Log = append(byID[deliveryID].Log, attempt)
+	}
+	return deliveries, rows.Err()
+}
+
+// rowsToDeliveries scans the delivery columns, followed by the URL and secret
+// of the webhook when withWebhook is set.
+func rowsToDeliveries(rows pgx.Rows, withWebhook bool) ([]tournament.WebhookDelivery, error) {
+	deliveries := []tournament.WebhookDelivery{}
+	for rows.Next() {
+		var delivery tournament.WebhookDelivery
+		var status, eventType string
+		var payload []byte
+		dest := []interface{}{&delivery.ID, &delivery.WebhookID, &status, &delivery.Attempts, &delivery.NextAttemptAt, &delivery.CreatedAt,
+			&delivery.Event.ID, &delivery.Event.CompetitionID, &eventType, &payload, &delivery.Event.At}
+		if withWebhook {
+			dest = append(dest, &delivery.URL, &delivery.Secret)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		delivery.Status = tournament.DeliveryStatus(status)
+		if err := decodeOutboxEvent(&delivery.Event, eventType, payload); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, rows.Err()
+}
+
+// Retry makes the dead delivery pending again. It keeps its attempts, so it
+// is dead again when the next attempt fails.
+func (w *WebhooksData) Retry(deliveryID int) error {
+	tag, err := w.pool.Exec(context.Background(),
+		"UPDATE webhook_deliveries SET status='pending', next_attempt_at=now() WHERE id=$1", deliveryID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return tournament.ErrDeliveryNotFound
+	}
+	return nil
+}
+
+func (w *WebhooksData) FanOut(limit int) ([]tournament.OutboxEvent, error) {
+	tx, err := w.pool.Begin(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(context.Background())
+
+	rows, err := tx.Query(context.Background(),
+		"SELECT "+outboxColumns+" FROM outbox o WHERE o.processed_at IS NULL ORDER BY o.id LIMIT $1 FOR UPDATE SKIP LOCKED", limit)
+	if err != nil {
+		return nil, err
+	}
+	events, err := rowsToOutboxEvents(rows)
+	rows.Close()
+	if err != nil || len(events) == 0 {
+		return events, err
+	}
+
+	ids := make([]int64, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+	_, err = tx.Exec(context.Background(),
+		"INSERT INTO webhook_deliveries(webhook_id, outbox_id) "+
+			"SELECT w.id, o.id FROM outbox o JOIN webhooks w ON w.active AND o.event_type = ANY(w.events) WHERE o.id = ANY($1) ORDER BY o.id, w.id", ids)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.Exec(context.Background(), "UPDATE outbox SET processed_at=now() WHERE id = ANY($1)", ids); err != nil {
+		return nil, err
+	}
+	return events, tx.Commit(context.Background())
+}
+
+func (w *WebhooksData) ChangeLeader(competitionID int, team string) (*tournament.OutboxEvent, error) {
+	tx, err := w.pool.Begin(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(context.Background())
+
+	var previous string
+	err = tx.QueryRow(context.Background(),
+		"SELECT team FROM standings_leaders WHERE competition_id=$1 FOR UPDATE", competitionID).Scan(&previous)
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, err
+	}
+	if previous == team {
+		return nil, nil
+	}
+
+	_, err = tx.Exec(context.Background(),
+		"INSERT INTO standings_leaders(competition_id, team) VALUES ($1, $2) ON CONFLICT (competition_id) DO UPDATE SET team=EXCLUDED.team",
+		competitionID, team)
+	if err != nil {
+		return nil, err
+	}
+	event := &tournament.OutboxEvent{
+		CompetitionID: competitionID,
+		Type:          tournament.LeaderChanged,
+		Leader:        &tournament.LeaderChange{Team: team, Previous: previous},
+	}
+	if err := saveOutboxEvent(tx, event); err != nil {
+		return nil, err
+	}
+	return event, tx.Commit(context.Background())
+}
+
+func (w *WebhooksData) ClaimDeliveries(limit int, lease time.Duration) ([]tournament.WebhookDelivery, error) {
+	rows, err := w.pool.Query(context.Background(),
+		"WITH claimed AS ("+
+			"SELECT d.id FROM webhook_deliveries d JOIN webhooks w ON w.id = d.webhook_id "+
+			"WHERE w.active AND d.status='pending' AND d.next_attempt_at<=now() "+
+			"ORDER BY d.next_attempt_at LIMIT $1 FOR UPDATE OF d SKIP LOCKED"+
+			") UPDATE webhook_deliveries d SET next_attempt_at=now()+make_interval(secs => $2) "+
+			"FROM claimed, outbox o, webhooks w WHERE d.id = claimed.id AND o.id = d.outbox_id AND w.id = d.webhook_id "+
+			"RETURNING "+deliveryColumns+", w.url, w.secret",
+		limit, lease.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return rowsToDeliveries(rows, true)
+}
+
+func (w *WebhooksData) RecordAttempt(delivery *tournament.WebhookDelivery, attempt *tournament.DeliveryAttempt) error {
+	tx, err := w.pool.Begin(context.Background())
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(context.Background())
+
+	_, err = tx.Exec(context.Background(),
+		"INSERT INTO webhook_delivery_attempts(delivery_id, attempt, status_code, error, duration_ms, at) VALUES ($1, $2, $3, $4, $5, $6)",
+		delivery.ID, attempt.Attempt, nullIfZero(attempt.StatusCode), nullIfEmpty(attempt.Error), attempt.Duration.Milliseconds(), attempt.At)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(context.Background(),
+		"UPDATE webhook_deliveries SET status=$2, attempts=$3, next_attempt_at=$4 WHERE id=$1",
+		delivery.ID, string(delivery.Status), delivery.Attempts, delivery.NextAttemptAt)
+	if err != nil {
+		return err
+	}
+	return tx.Commit(context.Background())
+}
+
+// OutboxChannel is the channel notified of the ID of every event stored in
+// the outbox.
+const OutboxChannel = "outbox"
+
+// ChangeBufferSize is the number of changes a subscriber can fall behind by
+// before the change feed waits for it.
+const ChangeBufferSize = 64
+
+// origin identifies the process among the replicas sharing the database.
+var origin = newOrigin()
+
+func newOrigin() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Change is an event stored in the outbox, by this process when Local.
+type Change struct {
+	Event tournament.OutboxEvent
+	Local bool
+}
+
+// ChangeFeed tells about the changes stored in the outbox by every replica
+// sharing the database.
+type ChangeFeed struct {
+	pool *pgxpool.Pool
+	// RetryDelay is the delay between the attempts to listen again when the
+	// connection is lost
+	RetryDelay time.Duration
+}
+
+func NewChangeFeed(p *pgxpool.Pool) *ChangeFeed {
+	return &ChangeFeed{p, 5 * time.Second}
+}
+
+// Subscribe returns the channel receiving the changes stored from now on,
+// closed when the context is done. It listens on a connection of its own,
+// and when the connection is lost it listens again and catches up with the
+// changes stored meanwhile, except for the transactions which stored events
+// before the latest one received but committed after it.
+func (f *ChangeFeed) Subscribe(ctx context.Context) (<-chan Change, error) {
+	conn, err := f.listen(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var lastID int64
+	if err := f.pool.QueryRow(ctx, "SELECT COALESCE(max(id), 0) FROM outbox").Scan(&lastID); err != nil {
+		conn.Close(context.Background())
+		return nil, err
+	}
+
+	changes := make(chan Change, ChangeBufferSize)
+	go f.serve(ctx, conn, lastID, changes)
+	return changes, nil
+}
+
+func (f *ChangeFeed) listen(ctx context.Context) (*pgx.Conn, error) {
+	conn, err := pgx.ConnectConfig(ctx, f.pool.Config().ConnConfig)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := conn.Exec(ctx, "LISTEN "+OutboxChannel); err != nil {
+		conn.Close(context.Background())
+		return nil, err
+	}
+	return conn, nil
+}
+
+func (f *ChangeFeed) serve(ctx context.Context, conn *pgx.Conn, lastID int64, changes chan<- Change) {
+	defer close(changes)
+
+	// caughtUp are the events sent when catching up, notified again
+	caughtUp := map[int64]bool{}
+	for {
+		err := f.forward(ctx, conn, &lastID, caughtUp, changes)
+		conn.Close(context.Background())
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("Change feed connection lost: %v", err)
+
+		for conn = nil; conn == nil; {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(f.RetryDelay):
+			}
+			if conn, err = f.listen(ctx); err != nil {
+				log.Printf("Error listening to changes: %v", err)
+			}
+		}
+
+		events, err := f.find("o.id > $1 ORDER BY o.id", lastID)
+		if err != nil {
+			log.Printf("Error catching up with changes: %v", err)
+		}
+		caughtUp = map[int64]bool{}
+		for _, e := range events {
+			caughtUp[e.event.ID] = true
+			if !f.send(ctx, e, &lastID, changes) {
+				conn.Close(context.Background())
+				return
+			}
+		}
+	}
+}
+
+// forward sends the notified events until the connection is lost or the
+// context is done.
+func (f *ChangeFeed) forward(ctx context.Context, conn *pgx.Conn, lastID *int64, caughtUp map[int64]bool, changes chan<- Change) error {
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		id, err := strconv.ParseInt(notification.Payload, 10, 64)
+		if err != nil || caughtUp[id] {
+			continue
+		}
+		events, err := f.find("o.id = $1", id)
+		if err != nil {
+			return err
+		}
+		for _, e := range events {
+			if !f.send(ctx, e, lastID, changes) {
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+type originEvent struct {
+	event  tournament.OutboxEvent
+	origin string
+}
+
+func (f *ChangeFeed) send(ctx context.Context, e originEvent, lastID *int64, changes chan<- Change) bool {
+	if e.event.ID > *lastID {
+		*lastID = e.event.ID
+	}
+	select {
+	case <-ctx.Done():
+		return false
+	case changes <- Change{Event: e.event, Local: e.origin == origin}:
+		return true
+	}
+}
+
+func (f *ChangeFeed) find(where string, args ...interface{}) ([]originEvent, error) {
+	rows, err := f.pool.Query(context.Background(),
+		"SELECT "+outboxColumns+", o.origin FROM outbox o WHERE "+where, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []originEvent{}
+	for rows.Next() {
+		var e originEvent
+		var eventType string
+		var payload []byte
+		if err := rows.Scan(&e.event.ID, &e.event.CompetitionID, &eventType, &payload, &e.event.At, &e.origin); err != nil {
+			return nil, err
+		}
+		if err := decodeOutboxEvent(&e.event, eventType, payload); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
+
+func nullIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func emptyIfNull(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func nullIfZero(i int) *int {
+	if i == 0 {
+		return nil
+	}
+	return &i
+}
+
+func zeroIfNull(i *int) int {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
+
+func nullIfZeroTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
 }
@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
@@ -34,9 +35,9 @@ func TestWaitForDb(t *testing.T) {
 func TestFindByTeam(t *testing.T) {
 	defer deleteAllGames()
 
-	g1, g2, g3 := tournament.Game{"A", 1, "B", 0}, tournament.Game{"B", 2, "C", 2}, tournament.Game{"C", 3, "A", 4}
+	g1, g2, g3 := tournament.Game{TeamA: "A", ScoreA: 1, TeamB: "B", ScoreB: 0}, tournament.Game{TeamA: "B", ScoreA: 2, TeamB: "C", ScoreB: 2}, tournament.Game{TeamA: "C", ScoreA: 3, TeamB: "A", ScoreB: 4}
 
-	gd := GamesData{dbPool}
+	gd := NewGameData(dbPool)
 	gd.Save(&g1)
 	gd.Save(&g2)
 	gd.Save(&g3)
@@ -53,7 +54,7 @@ func TestFindByTeam(t *testing.T) {
 }
 
 func TestFindByTeamNotFound(t *testing.T) {
-	gd := GamesData{dbPool}
+	gd := NewGameData(dbPool)
 
 	_, err := gd.FindByTeam("YOU_SHOULD_NOT_FIND_ME")
 	if err != tournament.ErrTeamNotFound {
@@ -61,12 +62,26 @@ func TestFindByTeamNotFound(t *testing.T) {
 	}
 }
 
+func TestFindTeam(t *testing.T) {
+	defer deleteAllGames()
+
+	gd := NewGameData(dbPool)
+	gd.Save(&tournament.Game{TeamA: "Lions", ScoreA: 1, TeamB: "Tigers", ScoreB: 0})
+
+	if team, err := gd.FindTeam("LIONS"); err != nil || team != "Lions" {
+		t.Errorf("Expected team Lions but got %v, %v", team, err)
+	}
+	if _, err := gd.FindTeam("Bears"); err != tournament.ErrTeamNotFound {
+		t.Errorf("Expecting ErrTeamNotFound error but got %v", err)
+	}
+}
+
 func TestFindAll(t *testing.T) {
 	defer deleteAllGames()
 
-	g1, g2, g3 := tournament.Game{"A", 1, "B", 0}, tournament.Game{"B", 2, "C", 2}, tournament.Game{"C", 3, "A", 4}
+	g1, g2, g3 := tournament.Game{TeamA: "A", ScoreA: 1, TeamB: "B", ScoreB: 0}, tournament.Game{TeamA: "B", ScoreA: 2, TeamB: "C", ScoreB: 2}, tournament.Game{TeamA: "C", ScoreA: 3, TeamB: "A", ScoreB: 4}
 
-	gd := GamesData{dbPool}
+	gd := NewGameData(dbPool)
 	gd.Save(&g1)
 	gd.Save(&g2)
 	gd.Save(&g3)
@@ -82,6 +97,901 @@ func TestFindAll(t *testing.T) {
 	}
 }
 
+func TestFixtures(t *testing.T) {
+	defer deleteAllFixtures()
+
+	fd := NewFixturesData(dbPool)
+	expected := tournament.RoundRobin([]string{"A", "B", "C"}, false)
+	if err := fd.Save(expected); err != nil {
+		t.Fatalf("Error saving fixtures: %v", err)
+	}
+
+	got, err := fd.FindAll()
+	if err != nil {
+		t.Fatalf("Error getting all fixtures: %v", err)
+	}
+
+	if !reflect.DeepEqual(fixturesAsMap(expected), fixturesAsMap(got)) {
+		t.Errorf("Expected fixtures %v but got %v", expected, got)
+	}
+}
+
+func TestUpdateFixture(t *testing.T) {
+	defer deleteAllFixtures()
+	defer deleteAllGames()
+
+	fd := NewFixturesData(dbPool)
+	fixtures := tournament.RoundRobin([]string{"A", "B"}, false)
+	if err := fd.Save(fixtures); err != nil {
+		t.Fatalf("Error saving fixtures: %v", err)
+	}
+	game := tournament.Game{TeamA: "A", ScoreA: 1, TeamB: "B", ScoreB: 0}
+	if err := NewGameData(dbPool).Save(&game); err != nil {
+		t.Fatalf("Error saving game: %v", err)
+	}
+
+	fixture := fixtures[0]
+	fixture.Status = tournament.Completed
+	fixture.GameID = game.ID
+	if err := fd.Update(&fixture); err != nil {
+		t.Fatalf("Error updating fixture: %v", err)
+	}
+
+	got, err := fd.FindByID(fixture.ID)
+	if err != nil {
+		t.Fatalf("Error getting fixture: %v", err)
+	}
+	if !reflect.DeepEqual(&fixture, got) {
+		t.Errorf("Expected fixture %v but got %v", fixture, got)
+	}
+
+	if _, err := fd.FindByID(-1); err != tournament.ErrFixtureNotFound {
+		t.Errorf("Expecting ErrFixtureNotFound error but got %v", err)
+	}
+}
+
+func TestSaveFixtureGame(t *testing.T) {
+	defer deleteAllFixtures()
+	defer deleteAllGames()
+
+	fd := NewFixturesData(dbPool)
+	gd := NewGameData(dbPool)
+	fixtures := tournament.RoundRobin([]string{"A", "B"}, false)
+	if err := fd.Save(fixtures); err != nil {
+		t.Fatalf("Error saving fixtures: %v", err)
+	}
+
+	unknown := tournament.Fixture{ID: -1, Status: tournament.Completed}
+	if err := gd.SaveFixtureGame(&tournament.Game{TeamA: "A", ScoreA: 1, TeamB: "B", ScoreB: 0}, &unknown, nil); err != tournament.ErrFixtureNotFound {
+		t.Errorf("Expecting ErrFixtureNotFound error but got %v", err)
+	}
+	if games, _ := gd.FindAll(); len(games) != 0 {
+		t.Errorf("Expected no game saved for unknown fixture but got %v", games)
+	}
+
+	fixture := fixtures[0]
+	fixture.Status = tournament.Completed
+	game := tournament.Game{TeamA: "A", ScoreA: 1, TeamB: "B", ScoreB: 0}
+	if err := gd.SaveFixtureGame(&game, &fixture, nil); err != nil {
+		t.Fatalf("Error saving fixture game: %v", err)
+	}
+	got, err := fd.FindByID(fixture.ID)
+	if err != nil {
+		t.Fatalf("Error getting fixture: %v", err)
+	}
+	if got.Status != tournament.Completed || got.GameID != game.ID {
+		t.Errorf("Expected fixture completed with game %v but got %v", game.ID, got)
+	}
+}
+
+func TestUpdateAndDeleteGame(t *testing.T) {
+	defer deleteAllGames()
+
+	playedAt := time.Date(2026, 5, 1, 15, 0, 0, 0, time.UTC)
+	game := tournament.Game{TeamA: "A", ScoreA: 1, TeamB: "B", ScoreB: 0, PlayedAt: playedAt}
+
+	gd := NewGameData(dbPool)
+	if err := gd.Save(&game); err != nil {
+		t.Fatalf("Error saving game: %v", err)
+	}
+	if game.ID == 0 || !game.PlayedAt.Equal(playedAt) || game.RecordedAt.IsZero() {
+		t.Errorf("Expected saved game with ID and timestamps, got %v", game)
+	}
+
+	game.ScoreB = 2
+	game.PlayedAt = time.Time{}
+	if err := gd.Update(&game); err != nil {
+		t.Fatalf("Error updating game: %v", err)
+	}
+	if !game.PlayedAt.Equal(playedAt) {
+		t.Errorf("Expected played time %v to be kept, got %v", playedAt, game.PlayedAt)
+	}
+
+	got, err := gd.FindByID(game.ID)
+	if err != nil {
+		t.Fatalf("Error getting game: %v", err)
+	}
+	if !reflect.DeepEqual(&game, got) {
+		t.Errorf("Expected game %v but got %v", game, got)
+	}
+
+	if err := gd.Delete(game.ID); err != nil {
+		t.Fatalf("Error deleting game: %v", err)
+	}
+	if _, err := gd.FindByID(game.ID); err != tournament.ErrGameNotFound {
+		t.Errorf("Expecting ErrGameNotFound error but got %v", err)
+	}
+	if err := gd.Update(&game); err != tournament.ErrGameNotFound {
+		t.Errorf("Expecting ErrGameNotFound error updating deleted game but got %v", err)
+	}
+	if err := gd.Delete(game.ID); err != tournament.ErrGameNotFound {
+		t.Errorf("Expecting ErrGameNotFound error deleting deleted game but got %v", err)
+	}
+}
+
+func TestFindGamesByResult(t *testing.T) {
+	defer deleteAllGames()
+
+	gd := NewGameData(dbPool)
+	games := []tournament.Game{
+		{TeamA: "A", ScoreA: 1, TeamB: "B", ScoreB: 1, DecidedIn: tournament.Penalties, PenaltiesA: 5, PenaltiesB: 4},
+		{TeamA: "B", ScoreA: 0, TeamB: "A", ScoreB: 0, Outcome: tournament.DoubleForfeit},
+		{TeamA: "A", ScoreA: 0, TeamB: "B", ScoreB: 3, Outcome: tournament.Forfeit},
+		{TeamA: "B", ScoreA: 2, TeamB: "A", ScoreB: 2},
+	}
+	for i := range games {
+		if err := gd.Save(&games[i]); err != nil {
+			t.Fatalf("Error saving game: %v", err)
+		}
+	}
+
+	var resultTestData = []struct {
+		testName string
+		filter   tournament.GameFilter
+		expected []tournament.Game
+	}{
+		{"wins by penalties", tournament.GameFilter{Team: "A", Result: tournament.WinResult, Scoring: tournament.OvertimeScoringRules, Limit: 10}, []tournament.Game{games[0]}},
+		{"losses by double forfeit and forfeit", tournament.GameFilter{Team: "A", Result: tournament.LossResult, Limit: 10}, []tournament.Game{games[1], games[2]}},
+		{"losses by penalties and double forfeit", tournament.GameFilter{Team: "B", Result: tournament.LossResult, Scoring: tournament.OvertimeScoringRules, Limit: 10}, []tournament.Game{games[0], games[1]}},
+		{"draws only", tournament.GameFilter{Team: "B", Result: tournament.DrawResult, Scoring: tournament.OvertimeScoringRules, Limit: 10}, []tournament.Game{games[3]}},
+		{"penalties drawn without overtime", tournament.GameFilter{Team: "B", Result: tournament.DrawResult, Limit: 10}, []tournament.Game{games[0], games[3]}},
+		{"forfeit won", tournament.GameFilter{Team: "B", Result: tournament.WinResult, Limit: 10}, []tournament.Game{games[2]}},
+	}
+
+	for _, testData := range resultTestData {
+		page, err := gd.Find(testData.filter)
+		if err != nil {
+			t.Fatalf("%v: error finding games: %v", testData.testName, err)
+		}
+		if !reflect.DeepEqual(testData.expected, page.Games) {
+			t.Errorf("%v: expected games %v but got %v", testData.testName, testData.expected, page.Games)
+		}
+	}
+}
+
+func TestFindGamesByAlias(t *testing.T) {
+	defer deleteAllTeams()
+
+	if err := NewTeamsData(dbPool).Save(&tournament.Team{Name: "Lions", Aliases: []string{"Lions FC"}}); err != nil {
+		t.Fatalf("Error saving team: %v", err)
+	}
+	gd := NewGameData(dbPool)
+	game := tournament.Game{TeamA: "Lions", ScoreA: 1, TeamB: "Tigers", ScoreB: 0}
+	if err := gd.Save(&game); err != nil {
+		t.Fatalf("Error saving game: %v", err)
+	}
+
+	games, err := gd.FindByTeam("lions fc")
+	if err != nil || len(games) != 1 || games[0].ID != game.ID {
+		t.Errorf("Expected game %v found by alias but got %v, %v", game.ID, games, err)
+	}
+	page, err := gd.Find(tournament.GameFilter{Team: "LIONS FC", Opponent: "tigers", Result: tournament.WinResult, Limit: 10})
+	if err != nil || len(page.Games) != 1 || page.Games[0].ID != game.ID {
+		t.Errorf("Expected game %v filtered by alias but got %v, %v", game.ID, page, err)
+	}
+}
+
+func TestFindGames(t *testing.T) {
+	defer deleteAllGames()
+
+	gd := NewGameData(dbPool)
+	day := func(d int) time.Time { return time.Date(2026, 5, d, 15, 0, 0, 0, time.UTC) }
+	games := []tournament.Game{
+		{TeamA: "A", ScoreA: 1, TeamB: "B", ScoreB: 0, PlayedAt: day(3)},
+		{TeamA: "C", ScoreA: 2, TeamB: "A", ScoreB: 2, PlayedAt: day(1)},
+		{TeamA: "B", ScoreA: 3, TeamB: "C", ScoreB: 1, PlayedAt: day(2)},
+		{TeamA: "B", ScoreA: 0, TeamB: "A", ScoreB: 2, PlayedAt: day(4)},
+	}
+	for i := range games {
+		gd.Save(&games[i])
+	}
+
+	var findGamesTestData = []struct {
+		testName string
+		filter   tournament.GameFilter
+		expected []tournament.Game
+	}{
+		{"team wins", tournament.GameFilter{Team: "A", Result: tournament.WinResult, Limit: 10}, []tournament.Game{games[0], games[3]}},
+		{"team draws", tournament.GameFilter{Team: "A", Result: tournament.DrawResult, Limit: 10}, []tournament.Game{games[1]}},
+		{"team losses", tournament.GameFilter{Team: "B", Result: tournament.LossResult, Limit: 10}, []tournament.Game{games[0], games[3]}},
+		{"opponent", tournament.GameFilter{Team: "C", Opponent: "B", Limit: 10}, []tournament.Game{games[2]}},
+		{"date range latest first", tournament.GameFilter{From: day(2), To: day(4), Descending: true, Limit: 10}, []tournament.Game{games[0], games[2]}},
+	}
+
+	for _, testData := range findGamesTestData {
+		page, err := gd.Find(testData.filter)
+		if err != nil {
+			t.Fatalf("%v: error finding games: %v", testData.testName, err)
+		}
+		if !reflect.DeepEqual(testData.expected, page.Games) || page.NextCursor != "" {
+			t.Errorf("%v: expected games %v but got %v", testData.testName, testData.expected, page)
+		}
+	}
+
+	page, err := gd.Find(tournament.GameFilter{Limit: 3})
+	if err != nil {
+		t.Fatalf("Error finding first page: %v", err)
+	}
+	if !reflect.DeepEqual([]tournament.Game{games[1], games[2], games[0]}, page.Games) || page.NextCursor == "" {
+		t.Errorf("Expected first page of 3 games with cursor but got %v", page)
+	}
+	after, _ := tournament.ParseGameCursor(page.NextCursor)
+	page, err = gd.Find(tournament.GameFilter{Limit: 3, After: after})
+	if err != nil {
+		t.Fatalf("Error finding second page: %v", err)
+	}
+	if !reflect.DeepEqual([]tournament.Game{games[3]}, page.Games) || page.NextCursor != "" {
+		t.Errorf("Expected last page of 1 game but got %v", page)
+	}
+}
+
+func TestAuditLog(t *testing.T) {
+	defer deleteAllGames()
+
+	game := tournament.Game{TeamA: "A", ScoreA: 1, TeamB: "B", ScoreB: 0}
+	NewGameData(dbPool).Save(&game)
+	changed := game
+	changed.ScoreB = 1
+
+	ad := NewAuditData(dbPool)
+	entries := []tournament.AuditEntry{
+		{GameID: game.ID, Action: tournament.CreateAction, Principal: "alice", RequestID: "r1", After: &game},
+		{GameID: game.ID, Action: tournament.UpdateAction, Principal: "bob", RequestID: "r2", Before: &game, After: &changed},
+	}
+	for i := range entries {
+		if err := ad.Record(&entries[i]); err != nil {
+			t.Fatalf("Error recording audit entry: %v", err)
+		}
+	}
+
+	history, err := ad.FindByGame(game.ID)
+	if err != nil {
+		t.Fatalf("Error getting game history: %v", err)
+	}
+	if len(history) != 2 || history[0].ID != entries[0].ID || history[1].Principal != "bob" ||
+		history[0].Before != nil || history[1].After.ScoreB != 1 || history[1].Before.ScoreB != 0 {
+		t.Errorf("Expected history %v but got %v", entries, history)
+	}
+
+	latest, err := ad.Find(tournament.AuditFilter{Principal: "alice", Limit: 10})
+	if err != nil {
+		t.Fatalf("Error finding audit entries: %v", err)
+	}
+	if len(latest) != 1 || latest[0].ID != entries[0].ID {
+		t.Errorf("Expected entries of alice %v but got %v", entries[:1], latest)
+	}
+
+	if _, err := dbPool.Exec(context.Background(), "DELETE FROM audit_log"); err == nil {
+		t.Errorf("Expected audit log to be append-only")
+	}
+}
+
+func TestAuditedGames(t *testing.T) {
+	defer deleteAllGames()
+
+	gd := NewGameData(dbPool)
+	game := tournament.Game{TeamA: "A", ScoreA: 1, TeamB: "B", ScoreB: 0}
+	if err := gd.SaveAudited(&game, &tournament.AuditEntry{Action: tournament.CreateAction, Principal: "alice"}); err != nil {
+		t.Fatalf("Error saving game: %v", err)
+	}
+	game.ScoreB = 1
+	if err := gd.UpdateAudited(&game, &tournament.AuditEntry{Action: tournament.UpdateAction, Principal: "bob"}); err != nil {
+		t.Fatalf("Error updating game: %v", err)
+	}
+	unknown := tournament.Game{ID: game.ID + 1, TeamA: "A", TeamB: "B"}
+	if err := gd.UpdateAudited(&unknown, &tournament.AuditEntry{Action: tournament.UpdateAction}); err != tournament.ErrGameNotFound {
+		t.Fatalf("Expected ErrGameNotFound updating unknown game but got %v", err)
+	}
+	if err := gd.DeleteAudited(game.ID, &tournament.AuditEntry{Action: tournament.DeleteAction, Principal: "alice"}); err != nil {
+		t.Fatalf("Error deleting game: %v", err)
+	}
+
+	history, err := NewAuditData(dbPool).FindByGame(game.ID)
+	if err != nil {
+		t.Fatalf("Error getting game history: %v", err)
+	}
+	if len(history) != 3 || history[0].After.ID != game.ID || history[1].After.ScoreB != 1 || history[2].After != nil {
+		t.Errorf("Expected create, update and delete of game %v but got %v", game.ID, history)
+	}
+	if unknownHistory, _ := NewAuditData(dbPool).FindByGame(unknown.ID); len(unknownHistory) != 0 {
+		t.Errorf("Expected no audit entry of failed update but got %v", unknownHistory)
+	}
+}
+
+func TestSavePenaltiesGame(t *testing.T) {
+	defer deleteAllGames()
+
+	game := tournament.Game{TeamA: "A", ScoreA: 1, TeamB: "B", ScoreB: 1, DecidedIn: tournament.Penalties, PenaltiesA: 4, PenaltiesB: 2}
+
+	gd := NewGameData(dbPool)
+	if err := gd.Save(&game); err != nil {
+		t.Fatalf("Error saving game: %v", err)
+	}
+
+	got, err := gd.FindAll()
+	if err != nil {
+		t.Fatalf("Error getting all games: %v", err)
+	}
+	if !reflect.DeepEqual([]tournament.Game{game}, got) {
+		t.Errorf("Expected games %v but got %v", []tournament.Game{game}, got)
+	}
+}
+
+func TestSaveAwardedGame(t *testing.T) {
+	defer deleteAllGames()
+
+	game := tournament.Game{TeamA: "A", ScoreA: 3, TeamB: "B", ScoreB: 0, Outcome: tournament.Forfeit}
+
+	gd := NewGameData(dbPool)
+	if err := gd.Save(&game); err != nil {
+		t.Fatalf("Error saving game: %v", err)
+	}
+
+	got, err := gd.FindByID(game.ID)
+	if err != nil {
+		t.Fatalf("Error getting game: %v", err)
+	}
+	if got.Outcome != tournament.Forfeit {
+		t.Errorf("Expected forfeit but got %v", got.Outcome)
+	}
+}
+
+func TestPointAdjustments(t *testing.T) {
+	defer deleteAllPointAdjustments()
+
+	ad := NewPointAdjustmentsData(dbPool)
+	adjustment := tournament.PointAdjustment{Team: "A", Delta: -3, Reason: "Unpaid fine", Principal: "league", RequestID: "req-1"}
+	if err := ad.Save(&adjustment); err != nil {
+		t.Fatalf("Error saving adjustment: %v", err)
+	}
+	if err := ad.ForCompetition(tournament.DefaultCompetitionID + 1).Save(&tournament.PointAdjustment{Team: "A", Delta: 1, Reason: "Bonus", Principal: "league"}); err != nil {
+		t.Fatalf("Error saving adjustment: %v", err)
+	}
+
+	got, err := ad.FindByTeam("a")
+	if err != nil {
+		t.Fatalf("Error getting adjustments: %v", err)
+	}
+	if len(got) != 1 || got[0].ID != adjustment.ID || got[0].Delta != -3 || got[0].Reason != "Unpaid fine" || got[0].At.IsZero() {
+		t.Errorf("Expected adjustment %v but got %v", adjustment, got)
+	}
+}
+
+func TestWebhookOutbox(t *testing.T) {
+	deleteAllWebhooks()
+	defer deleteAllWebhooks()
+	defer deleteAllGames()
+
+	wd := NewWebhooksData(dbPool)
+	webhook := tournament.Webhook{URL: "https://cms.example.com", Events: []tournament.EventType{tournament.GameDeleted, tournament.LeaderChanged}, Secret: "s", Active: true}
+	if err := wd.Save(&webhook); err != nil {
+		t.Fatalf("Error saving webhook: %v", err)
+	}
+
+	gd := NewGameData(dbPool)
+	game := tournament.Game{TeamA: "A", ScoreA: 1, TeamB: "B", ScoreB: 0}
+	gd.Save(&game)
+	gd.Delete(game.ID)
+	leader, err := wd.ChangeLeader(tournament.DefaultCompetitionID, "A")
+	if err != nil || leader == nil || leader.Leader.Previous != "" {
+		t.Fatalf("Expected first leader event but got %v, %v", leader, err)
+	}
+	if same, err := wd.ChangeLeader(tournament.DefaultCompetitionID, "A"); same != nil || err != nil {
+		t.Errorf("Expected no event for the same leader but got %v, %v", same, err)
+	}
+
+	events, err := wd.FanOut(10)
+	if err != nil {
+		t.Fatalf("Error fanning out events: %v", err)
+	}
+	if len(events) != 3 || events[0].Type != tournament.GameCreated || events[1].Game == nil || events[1].Game.TeamA != "A" {
+		t.Errorf("Expected game created, game deleted and leader events but got %v", events)
+	}
+	if again, _ := wd.FanOut(10); len(again) != 0 {
+		t.Errorf("Expected events fanned out once but got %v", again)
+	}
+
+	deliveries, err := wd.ClaimDeliveries(10, time.Minute)
+	if err != nil {
+		t.Fatalf("Error claiming deliveries: %v", err)
+	}
+	if len(deliveries) != 2 || deliveries[0].URL != webhook.URL || deliveries[0].Secret != "s" {
+		t.Fatalf("Expected 2 deliveries to the webhook but got %v", deliveries)
+	}
+	if claimed, _ := wd.ClaimDeliveries(10, time.Minute); len(claimed) != 0 {
+		t.Errorf("Expected deliveries claimed once but got %v", claimed)
+	}
+
+	delivery := deliveries[0]
+	delivery.Status, delivery.Attempts = tournament.DeliveryDead, 1
+	err = wd.RecordAttempt(&delivery, &tournament.DeliveryAttempt{Attempt: 1, StatusCode: 500, Error: "failed", Duration: time.Second, At: time.Now()})
+	if err != nil {
+		t.Fatalf("Error recording attempt: %v", err)
+	}
+	dead, err := wd.FindDeliveries(webhook.ID, tournament.DeliveryFilter{Status: tournament.DeliveryDead, Limit: 10})
+	if err != nil || len(dead) != 1 || len(dead[0].Log) != 1 || dead[0].Log[0].StatusCode != 500 {
+		t.Errorf("Expected dead delivery with its attempt but got %v, %v", dead, err)
+	}
+
+	if err := wd.Delete(webhook.ID); err != nil {
+		t.Fatalf("Error deleting webhook: %v", err)
+	}
+	if _, err := wd.FindDelivery(delivery.ID); err != tournament.ErrDeliveryNotFound {
+		t.Errorf("Expecting ErrDeliveryNotFound error but got %v", err)
+	}
+}
+
+func TestOutboxOfFixturesAndAdjustments(t *testing.T) {
+	deleteAllWebhooks()
+	defer deleteAllWebhooks()
+	defer deleteAllFixtures()
+	defer deleteAllPointAdjustments()
+
+	fd := NewFixturesData(dbPool)
+	fixtures := tournament.RoundRobin([]string{"A", "B"}, false)
+	if err := fd.Save(fixtures); err != nil {
+		t.Fatalf("Error saving fixtures: %v", err)
+	}
+	fixture := fixtures[0]
+	fixture.Status = tournament.Postponed
+	if err := fd.Update(&fixture); err != nil {
+		t.Fatalf("Error updating fixture: %v", err)
+	}
+	adjustment := tournament.PointAdjustment{Team: "A", Delta: -3, Reason: "Unpaid fine", Principal: "league"}
+	if err := NewPointAdjustmentsData(dbPool).Save(&adjustment); err != nil {
+		t.Fatalf("Error saving adjustment: %v", err)
+	}
+
+	events, err := NewWebhooksData(dbPool).FanOut(10)
+	if err != nil {
+		t.Fatalf("Error fanning out events: %v", err)
+	}
+	if len(events) != 2 || events[0].Type != tournament.FixtureUpdated || events[0].Fixture == nil || events[0].Fixture.Status != tournament.Postponed ||
+		events[1].Type != tournament.PointsAdjusted || events[1].Adjustment == nil || events[1].Adjustment.ID != adjustment.ID {
+		t.Errorf("Expected fixture updated and points adjusted events but got %v", events)
+	}
+}
+
+func TestChangeFeed(t *testing.T) {
+	defer deleteAllWebhooks()
+	defer deleteAllGames()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changes, err := NewChangeFeed(dbPool).Subscribe(ctx)
+	if err != nil {
+		t.Fatalf("Error subscribing to changes: %v", err)
+	}
+
+	game := tournament.Game{TeamA: "A", ScoreA: 1, TeamB: "B", ScoreB: 0}
+	if err := NewGameData(dbPool).Save(&game); err != nil {
+		t.Fatalf("Error saving game: %v", err)
+	}
+
+	select {
+	case change := <-changes:
+		if !change.Local || change.Event.Type != tournament.GameCreated || change.Event.Game == nil || change.Event.Game.ID != game.ID {
+			t.Errorf("Expected local change creating game %v but got %v", game.ID, change)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Expected change creating game %v", game.ID)
+	}
+
+	cancel()
+	for range changes {
+	}
+}
+
+func TestBrackets(t *testing.T) {
+	defer deleteAllBrackets()
+
+	bd := NewBracketsData(dbPool)
+	bracket, _ := tournament.NewBracket("cup", []string{"A", "B", "C"})
+	if err := bd.Save(bracket); err != nil {
+		t.Fatalf("Error saving bracket: %v", err)
+	}
+
+	got, err := bd.FindByID(bracket.ID)
+	if err != nil {
+		t.Fatalf("Error getting bracket: %v", err)
+	}
+	if !reflect.DeepEqual(bracket, got) {
+		t.Errorf("Expected bracket %v but got %v", bracket, got)
+	}
+
+	bracket.Matches[1].Winner = "C"
+	bracket.Final().TeamB = "C"
+	if err := bd.Update(bracket); err != nil {
+		t.Fatalf("Error updating bracket: %v", err)
+	}
+
+	all, err := bd.FindAll()
+	if err != nil {
+		t.Fatalf("Error getting all brackets: %v", err)
+	}
+	if !reflect.DeepEqual([]tournament.Bracket{*bracket}, all) {
+		t.Errorf("Expected brackets %v but got %v", []tournament.Bracket{*bracket}, all)
+	}
+}
+
+func TestBracketNotFound(t *testing.T) {
+	bd := NewBracketsData(dbPool)
+
+	_, err := bd.FindByID(-1)
+	if err != tournament.ErrBracketNotFound {
+		t.Fatalf("Expecting ErrBracketNotFound error but got %v", err)
+	}
+}
+
+func TestGroupStages(t *testing.T) {
+	defer deleteAllBrackets()
+
+	gd := NewGroupStagesData(dbPool)
+	stage := tournament.GroupStage{
+		Name: "cup",
+		Groups: []tournament.Group{
+			{Name: "A", Teams: []string{"A1", "A2", "A3"}},
+			{Name: "B", Teams: []string{"B1", "B2", "B3"}},
+		},
+		Qualifiers: 2,
+	}
+	if err := gd.Save(&stage); err != nil {
+		t.Fatalf("Error saving group stage: %v", err)
+	}
+
+	bd := NewBracketsData(dbPool)
+	bracket, _ := tournament.NewBracket("cup", []string{"A1", "B1", "A2", "B2"})
+	bd.Save(bracket)
+	stage.BracketID = bracket.ID
+	if err := gd.Update(&stage); err != nil {
+		t.Fatalf("Error updating group stage: %v", err)
+	}
+
+	got, err := gd.FindByID(stage.ID)
+	if err != nil {
+		t.Fatalf("Error getting group stage: %v", err)
+	}
+	if !reflect.DeepEqual(&stage, got) {
+		t.Errorf("Expected group stage %v but got %v", stage, got)
+	}
+
+	if _, err := gd.FindByID(-1); err != tournament.ErrGroupStageNotFound {
+		t.Errorf("Expecting ErrGroupStageNotFound error but got %v", err)
+	}
+}
+
+func TestCompetitions(t *testing.T) {
+	defer deleteAllCompetitions()
+
+	cd := NewCompetitionsData(dbPool)
+	competition := tournament.Competition{Name: "League", Season: "2026", Format: tournament.LeagueFormat, Scoring: tournament.TwoPointScoringRules,
+		Zones: tournament.Zones{Promotion: 2, PromotionPlayoff: 4, Relegation: 3}}
+	if err := cd.Save(&competition); err != nil {
+		t.Fatalf("Error saving competition: %v", err)
+	}
+
+	got, err := cd.FindByID(competition.ID)
+	if err != nil {
+		t.Fatalf("Error getting competition: %v", err)
+	}
+	if !reflect.DeepEqual(&competition, got) {
+		t.Errorf("Expected competition %v but got %v", competition, got)
+	}
+
+	all, err := cd.FindAll()
+	if err != nil {
+		t.Fatalf("Error getting all competitions: %v", err)
+	}
+	if len(all) != 2 || all[0].ID != tournament.DefaultCompetitionID {
+		t.Errorf("Expected default and created competitions but got %v", all)
+	}
+
+	if _, err := cd.FindByID(-1); err != tournament.ErrCompetitionNotFound {
+		t.Errorf("Expecting ErrCompetitionNotFound error but got %v", err)
+	}
+}
+
+func TestUpdateScoring(t *testing.T) {
+	cd := NewCompetitionsData(dbPool)
+	defer cd.UpdateScoring(tournament.DefaultCompetitionID, tournament.DefaultScoringRules)
+
+	rules := tournament.ScoringRules{Win: 2, Draw: 1, BigWinMargin: 3, BigWinBonus: 1, Overtime: true, OvertimeWin: 2, OvertimeLoss: 1}
+	if err := cd.UpdateScoring(tournament.DefaultCompetitionID, rules); err != nil {
+		t.Fatalf("Error updating scoring rules: %v", err)
+	}
+	got, err := cd.FindByID(tournament.DefaultCompetitionID)
+	if err != nil || got.Scoring != rules {
+		t.Errorf("Expected scoring rules %v but got %v, %v", rules, got, err)
+	}
+
+	if err := cd.UpdateScoring(-1, rules); err != tournament.ErrCompetitionNotFound {
+		t.Errorf("Expecting ErrCompetitionNotFound error but got %v", err)
+	}
+}
+
+func TestCloseCompetition(t *testing.T) {
+	defer deleteAllCompetitions()
+
+	cd := NewCompetitionsData(dbPool)
+	competition := tournament.Competition{Name: "League", Season: "2026", Format: tournament.LeagueFormat, Scoring: tournament.DefaultScoringRules,
+		Teams: []string{"A", "B"}}
+	cd.Save(&competition)
+
+	standings := []tournament.Stats{
+		{Team: "A", Played: 1, Won: 1, GoalsFor: 2, GoalDifference: 2, Points: 3},
+		{Team: "B", Played: 1, Lost: 1, GoalsAgainst: 2, GoalDifference: -2},
+	}
+	if err := cd.Close(competition.ID, standings); err != nil {
+		t.Fatalf("Error closing competition: %v", err)
+	}
+	if err := cd.Close(competition.ID, standings); err != tournament.ErrSeasonClosed {
+		t.Errorf("Expecting ErrSeasonClosed error but got %v", err)
+	}
+
+	got, err := cd.FindByID(competition.ID)
+	if err != nil {
+		t.Fatalf("Error getting competition: %v", err)
+	}
+	competition.Closed = true
+	if !reflect.DeepEqual(&competition, got) {
+		t.Errorf("Expected competition %v but got %v", competition, got)
+	}
+
+	gotStandings, err := cd.FindStandings(competition.ID)
+	if err != nil {
+		t.Fatalf("Error getting standings: %v", err)
+	}
+	if !reflect.DeepEqual(standings, gotStandings) {
+		t.Errorf("Expected standings %v but got %v", standings, gotStandings)
+	}
+}
+
+func TestCloseCompetitionWithAdjustments(t *testing.T) {
+	defer deleteAllCompetitions()
+	defer deleteAllTeams()
+
+	cd := NewCompetitionsData(dbPool)
+	competition := tournament.Competition{Name: "League", Season: "2026", Format: tournament.LeagueFormat, Scoring: tournament.DefaultScoringRules}
+	cd.Save(&competition)
+
+	standings := []tournament.Stats{
+		{Team: "Lions", Played: 1, Won: 1, GoalsFor: 3, GoalDifference: 3, Points: 1, Adjustment: -2, AwardedGoalsFor: 3},
+		{Team: "Tigers", Played: 1, Lost: 1, GoalsAgainst: 3, GoalDifference: -3, AwardedGoalsAgainst: 3},
+		{Team: "Loins", Points: -1, Adjustment: -1},
+	}
+	if err := cd.Close(competition.ID, standings); err != nil {
+		t.Fatalf("Error closing competition: %v", err)
+	}
+	gotStandings, err := cd.FindStandings(competition.ID)
+	if err != nil {
+		t.Fatalf("Error getting standings: %v", err)
+	}
+	if !reflect.DeepEqual(standings, gotStandings) {
+		t.Errorf("Expected standings %v but got %v", standings, gotStandings)
+	}
+
+	td := NewTeamsData(dbPool)
+	lions, typo := tournament.Team{Name: "Lions"}, tournament.Team{Name: "Loins"}
+	td.Save(&lions)
+	td.Save(&typo)
+	if err := td.Merge(&typo, &lions, &tournament.TeamChange{TeamID: lions.ID, Action: tournament.MergeAction, From: "Loins", To: "Lions"}); err != nil {
+		t.Fatalf("Error merging teams: %v", err)
+	}
+
+	gotStandings, _ = cd.FindStandings(competition.ID)
+	if len(gotStandings) != 2 || gotStandings[0].Points != 0 || gotStandings[0].Adjustment != -3 || gotStandings[0].AwardedGoalsFor != 3 {
+		t.Errorf("Expected merged standings with the adjustments of both teams but got %v", gotStandings)
+	}
+}
+
+func TestCloseCompetitionWithOvertime(t *testing.T) {
+	defer deleteAllCompetitions()
+
+	cd := NewCompetitionsData(dbPool)
+	competition := tournament.Competition{Name: "Hockey", Season: "2026", Format: tournament.LeagueFormat, Scoring: tournament.OvertimeScoringRules}
+	cd.Save(&competition)
+
+	standings := []tournament.Stats{
+		{Team: "A", Played: 2, Won: 2, GoalsFor: 5, GoalsAgainst: 2, GoalDifference: 3, Points: 5, RegulationWon: 1, OvertimeWon: 1},
+		{Team: "B", Played: 1, Lost: 1, GoalsFor: 2, GoalsAgainst: 3, GoalDifference: -1, Points: 1, OvertimeLost: 1},
+		{Team: "C", Played: 1, Lost: 1, GoalsAgainst: 2, GoalDifference: -2},
+	}
+	if err := cd.Close(competition.ID, standings); err != nil {
+		t.Fatalf("Error closing competition: %v", err)
+	}
+	gotStandings, err := cd.FindStandings(competition.ID)
+	if err != nil {
+		t.Fatalf("Error getting standings: %v", err)
+	}
+	if !reflect.DeepEqual(standings, gotStandings) {
+		t.Errorf("Expected standings %v but got %v", standings, gotStandings)
+	}
+}
+
+func TestGamesScopedToCompetition(t *testing.T) {
+	defer deleteAllCompetitions()
+
+	competition := tournament.Competition{Name: "Cup", Format: tournament.KnockoutFormat, Scoring: tournament.DefaultScoringRules}
+	NewCompetitionsData(dbPool).Save(&competition)
+
+	g1 := tournament.Game{TeamA: "A", ScoreA: 1, TeamB: "B", ScoreB: 0}
+	g2 := tournament.Game{TeamA: "A", ScoreA: 2, TeamB: "C", ScoreB: 2}
+	defaultGames := NewGameData(dbPool)
+	cupGames := defaultGames.ForCompetition(competition.ID)
+	defaultGames.Save(&g1)
+	cupGames.Save(&g2)
+
+	got, err := cupGames.FindByTeam("A")
+	if err != nil {
+		t.Fatalf("Error getting team A games: %v", err)
+	}
+	if !reflect.DeepEqual([]tournament.Game{g2}, got) {
+		t.Errorf("Expected cup games %v but got %v", []tournament.Game{g2}, got)
+	}
+
+	if _, err := cupGames.FindByTeam("B"); err != tournament.ErrTeamNotFound {
+		t.Errorf("Expecting ErrTeamNotFound error for team of other competition but got %v", err)
+	}
+}
+
+func TestTeams(t *testing.T) {
+	defer deleteAllTeams()
+
+	td := NewTeamsData(dbPool)
+	lions := tournament.Team{Name: "Lions", ShortCode: "LIO", Aliases: []string{"Lions FC", "The Lions"}}
+	if err := td.Save(&lions); err != nil {
+		t.Fatalf("Error saving team: %v", err)
+	}
+	if err := td.Save(&tournament.Team{Name: "LIONS"}); err != tournament.ErrTeamExists {
+		t.Errorf("Expecting ErrTeamExists error but got %v", err)
+	}
+
+	got, err := td.FindByName("the lions")
+	if err != nil {
+		t.Fatalf("Error finding team by alias: %v", err)
+	}
+	if !reflect.DeepEqual(&lions, got) {
+		t.Errorf("Expected team %v but got %v", lions, got)
+	}
+
+	gd := NewGameData(dbPool)
+	game := tournament.Game{TeamA: "Lions FC", ScoreA: 1, TeamB: "Tigers", ScoreB: 0}
+	if err := gd.Save(&game); err != nil {
+		t.Fatalf("Error saving game: %v", err)
+	}
+
+	lions.Name = "Big Cats"
+	if err := td.Update(&lions); err != nil {
+		t.Fatalf("Error updating team: %v", err)
+	}
+	games, err := gd.FindByTeam("Big Cats")
+	if err != nil || len(games) != 1 || games[0].TeamB != "Tigers" {
+		t.Errorf("Expected game of renamed team but got %v, %v", games, err)
+	}
+
+	all, err := td.FindAll()
+	if err != nil || len(all) != 2 {
+		t.Errorf("Expected team registered with game but got %v, %v", all, err)
+	}
+
+	if err := td.Delete(lions.ID); err != tournament.ErrTeamInUse {
+		t.Errorf("Expecting ErrTeamInUse error but got %v", err)
+	}
+	if _, err := td.FindByID(-1); err != tournament.ErrTeamNotFound {
+		t.Errorf("Expecting ErrTeamNotFound error but got %v", err)
+	}
+}
+
+func TestMergeTeamsRenumbersStandings(t *testing.T) {
+	defer deleteAllCompetitions()
+	defer deleteAllTeams()
+
+	cd := NewCompetitionsData(dbPool)
+	competition := tournament.Competition{Name: "League", Season: "2026", Format: tournament.LeagueFormat, Scoring: tournament.DefaultScoringRules}
+	cd.Save(&competition)
+	standings := []tournament.Stats{{Team: "Lions", Points: 3}, {Team: "Loins", Points: 1}, {Team: "Tigers"}}
+	if err := cd.Close(competition.ID, standings); err != nil {
+		t.Fatalf("Error closing competition: %v", err)
+	}
+
+	td := NewTeamsData(dbPool)
+	lions, typo := tournament.Team{Name: "Lions"}, tournament.Team{Name: "Loins"}
+	td.Save(&lions)
+	td.Save(&typo)
+	if err := td.Merge(&typo, &lions, &tournament.TeamChange{TeamID: lions.ID, Action: tournament.MergeAction, From: "Loins", To: "Lions"}); err != nil {
+		t.Fatalf("Error merging teams: %v", err)
+	}
+
+	rows, err := dbPool.Query(context.Background(),
+		"SELECT position, team FROM standings_snapshots WHERE competition_id=$1 ORDER BY position", competition.ID)
+	if err != nil {
+		t.Fatalf("Error getting standings positions: %v", err)
+	}
+	defer rows.Close()
+	positions := map[int]string{}
+	for rows.Next() {
+		var position int
+		var team string
+		if err := rows.Scan(&position, &team); err != nil {
+			t.Fatalf("Error reading standings position: %v", err)
+		}
+		positions[position] = team
+	}
+	if expected := map[int]string{0: "Lions", 1: "Tigers"}; !reflect.DeepEqual(expected, positions) {
+		t.Errorf("Expected positions %v but got %v", expected, positions)
+	}
+}
+
+func TestMergeTeams(t *testing.T) {
+	defer deleteAllCompetitions()
+	defer deleteAllTeams()
+
+	gd := NewGameData(dbPool)
+	for _, game := range []tournament.Game{
+		{TeamA: "Lions", ScoreA: 1, TeamB: "Tigers", ScoreB: 0},
+		{TeamA: "Bears", ScoreA: 2, TeamB: "Loins", ScoreB: 2},
+	} {
+		if err := gd.Save(&game); err != nil {
+			t.Fatalf("Error saving game: %v", err)
+		}
+	}
+	fd := NewFixturesData(dbPool)
+	if err := fd.Save([]tournament.Fixture{{Round: 1, HomeTeam: "Loins", AwayTeam: "Tigers"}}); err != nil {
+		t.Fatalf("Error saving fixtures: %v", err)
+	}
+
+	td := NewTeamsData(dbPool)
+	lions, _ := td.FindByName("Lions")
+	typo, _ := td.FindByName("Loins")
+	tigers, _ := td.FindByName("Tigers")
+
+	if err := td.Merge(lions, tigers, &tournament.TeamChange{TeamID: tigers.ID, Action: tournament.MergeAction}); err != tournament.ErrTeamsPlayedEachOther {
+		t.Errorf("Expecting ErrTeamsPlayedEachOther error but got %v", err)
+	}
+
+	lions.Aliases = []string{"Loins"}
+	change := tournament.TeamChange{TeamID: lions.ID, Action: tournament.MergeAction, From: "Loins", To: "Lions", Principal: "admin"}
+	if err := td.Merge(typo, lions, &change); err != nil {
+		t.Fatalf("Error merging teams: %v", err)
+	}
+
+	games, err := gd.FindByTeam("Lions")
+	if err != nil || len(games) != 2 {
+		t.Errorf("Expected both games of merged team but got %v, %v", games, err)
+	}
+	fixtures, _ := fd.FindAll()
+	if len(fixtures) != 1 || fixtures[0].HomeTeam != "Lions" {
+		t.Errorf("Expected fixture of merged team but got %v", fixtures)
+	}
+	if _, err := td.FindByID(typo.ID); err != tournament.ErrTeamNotFound {
+		t.Errorf("Expecting merged team removed but got %v", err)
+	}
+
+	changes, err := td.FindChanges(lions.ID)
+	if err != nil || len(changes) != 1 || changes[0].ID != change.ID || changes[0].Principal != "admin" {
+		t.Errorf("Expected merge recorded but got %v, %v", changes, err)
+	}
+}
+
 func TestMain(m *testing.M) {
 	testExitCode := 0
 	defer func() { os.Exit(testExitCode) }()
@@ -109,6 +1019,14 @@ func asMap(games []tournament.Game) map[tournament.Game]bool {
 	return m
 }
 
+func fixturesAsMap(fixtures []tournament.Fixture) map[tournament.Fixture]bool {
+	m := make(map[tournament.Fixture]bool)
+	for _, f := range fixtures {
+		m[f] = true
+	}
+	return m
+}
+
 func createTestDatabase() error {
 	conn, err := pgx.Connect(context.Background(), dbServerUrl)
 	if err != nil {
@@ -144,15 +1062,60 @@ func dropTestDatabase() {
 }
 
 func deleteAllGames() {
-	_, err := dbPool.Exec(context.Background(), "TRUNCATE games;")
+	_, err := dbPool.Exec(context.Background(), "TRUNCATE games CASCADE;")
 	if err != nil {
 		log.Panicf("Unable to delete all games: %v", err)
 	}
 }
 
+func deleteAllFixtures() {
+	_, err := dbPool.Exec(context.Background(), "TRUNCATE fixtures;")
+	if err != nil {
+		log.Panicf("Unable to delete all fixtures: %v", err)
+	}
+}
+
+func deleteAllBrackets() {
+	_, err := dbPool.Exec(context.Background(), "TRUNCATE brackets, group_stages CASCADE;")
+	if err != nil {
+		log.Panicf("Unable to delete all brackets: %v", err)
+	}
+}
+
+func deleteAllCompetitions() {
+	_, err := dbPool.Exec(context.Background(), "TRUNCATE games, fixtures, brackets, group_stages CASCADE;")
+	if err == nil {
+		_, err = dbPool.Exec(context.Background(), "DELETE FROM competitions WHERE id <> $1;", tournament.DefaultCompetitionID)
+	}
+	if err != nil {
+		log.Panicf("Unable to delete all competitions: %v", err)
+	}
+}
+
+func deleteAllTeams() {
+	_, err := dbPool.Exec(context.Background(), "TRUNCATE teams CASCADE;")
+	if err != nil {
+		log.Panicf("Unable to delete all teams: %v", err)
+	}
+}
+
+func deleteAllPointAdjustments() {
+	_, err := dbPool.Exec(context.Background(), "TRUNCATE point_adjustments;")
+	if err != nil {
+		log.Panicf("Unable to delete all point adjustments: %v", err)
+	}
+}
+
 func getEnv(name, defaultValue string) string {
 	if value := os.Getenv(name); value != "" {
 		return value
 	}
 	return defaultValue
 }
+
+func deleteAllWebhooks() {
+	_, err := dbPool.Exec(context.Background(), "TRUNCATE webhooks, outbox, standings_leaders CASCADE;")
+	if err != nil {
+		log.Panicf("Unable to delete all webhooks: %v", err)
+	}
+}
@@ -7,6 +7,7 @@ package models
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -19,6 +20,35 @@ import (
 // swagger:model game
 type Game struct {
 
+	// Period in which a game that needed a winner was settled
+	// Enum: [regulation extraTime penalties]
+	DecidedIn *string `json:"decidedIn,omitempty"`
+
+	// id
+	// Read Only: true
+	ID int64 `json:"id,omitempty"`
+
+	// Whether the game was played or its result awarded, the awarded scores naming the winner of a forfeit or walkover
+	// Enum: [played forfeit walkover doubleForfeit]
+	Outcome *string `json:"outcome,omitempty"`
+
+	// penalties a
+	// Minimum: 0
+	PenaltiesA *int64 `json:"penaltiesA,omitempty"`
+
+	// penalties b
+	// Minimum: 0
+	PenaltiesB *int64 `json:"penaltiesB,omitempty"`
+
+	// When the game was played, the time it is recorded when not set
+	// Format: date-time
+	PlayedAt strfmt.DateTime `json:"playedAt,omitempty"`
+
+	// recorded at
+	// Read Only: true
+	// Format: date-time
+	RecordedAt strfmt.DateTime `json:"recordedAt,omitempty"`
+
 	// score a
 	// Required: true
 	ScoreA *int64 `json:"scoreA"`
@@ -42,6 +72,30 @@ type Game struct {
 func (m *Game) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateDecidedIn(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOutcome(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePenaltiesA(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePenaltiesB(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePlayedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRecordedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateScoreA(formats); err != nil {
 		res = append(res, err)
 	}
@@ -64,6 +118,147 @@ func (m *Game) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+var gameTypeDecidedInPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["regulation","extraTime","penalties"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		gameTypeDecidedInPropEnum = append(gameTypeDecidedInPropEnum, v)
+	}
+}
+
+const (
+
+	// GameDecidedInRegulation captures enum value "regulation"
+	GameDecidedInRegulation string = "regulation"
+
+	// GameDecidedInExtraTime captures enum value "extraTime"
+	GameDecidedInExtraTime string = "extraTime"
+
+	// GameDecidedInPenalties captures enum value "penalties"
+	GameDecidedInPenalties string = "penalties"
+)
+
+// prop value enum
+func (m *Game) validateDecidedInEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, gameTypeDecidedInPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Game) validateDecidedIn(formats strfmt.Registry) error {
+	if swag.IsZero(m.DecidedIn) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateDecidedInEnum("decidedIn", "body", *m.DecidedIn); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var gameTypeOutcomePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["played","forfeit","walkover","doubleForfeit"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		gameTypeOutcomePropEnum = append(gameTypeOutcomePropEnum, v)
+	}
+}
+
+const (
+
+	// GameOutcomePlayed captures enum value "played"
+	GameOutcomePlayed string = "played"
+
+	// GameOutcomeForfeit captures enum value "forfeit"
+	GameOutcomeForfeit string = "forfeit"
+
+	// GameOutcomeWalkover captures enum value "walkover"
+	GameOutcomeWalkover string = "walkover"
+
+	// GameOutcomeDoubleForfeit captures enum value "doubleForfeit"
+	GameOutcomeDoubleForfeit string = "doubleForfeit"
+)
+
+// prop value enum
+func (m *Game) validateOutcomeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, gameTypeOutcomePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Game) validateOutcome(formats strfmt.Registry) error {
+	if swag.IsZero(m.Outcome) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateOutcomeEnum("outcome", "body", *m.Outcome); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Game) validatePenaltiesA(formats strfmt.Registry) error {
+	if swag.IsZero(m.PenaltiesA) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("penaltiesA", "body", *m.PenaltiesA, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Game) validatePenaltiesB(formats strfmt.Registry) error {
+	if swag.IsZero(m.PenaltiesB) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("penaltiesB", "body", *m.PenaltiesB, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Game) validatePlayedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.PlayedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("playedAt", "body", "date-time", m.PlayedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Game) validateRecordedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.RecordedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("recordedAt", "body", "date-time", m.RecordedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Game) validateScoreA(formats strfmt.Registry) error {
 
 	if err := validate.Required("scoreA", "body", m.ScoreA); err != nil {
@@ -108,8 +303,39 @@ func (m *Game) validateTeamB(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this game based on context it is used
+// ContextValidate validate this game based on the context it is used
 func (m *Game) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateID(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRecordedAt(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Game) contextValidateID(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "id", "body", int64(m.ID)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Game) contextValidateRecordedAt(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "recordedAt", "body", strfmt.DateTime(m.RecordedAt)); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -7,6 +7,7 @@ package models
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -19,22 +20,55 @@ import (
 // swagger:model stats
 type Stats struct {
 
+	// Total of the point adjustments
+	Adjustment int64 `json:"adjustment,omitempty"`
+
+	// Part of goalsAgainst coming from awarded results
+	AwardedGoalsAgainst int64 `json:"awardedGoalsAgainst,omitempty"`
+
+	// Part of goalsFor coming from awarded results
+	AwardedGoalsFor int64 `json:"awardedGoalsFor,omitempty"`
+
+	// Points earned in games
+	BasePoints int64 `json:"basePoints,omitempty"`
+
 	// drawn
 	// Required: true
 	Drawn *int64 `json:"drawn"`
 
+	// goal difference
+	// Required: true
+	GoalDifference *int64 `json:"goalDifference"`
+
+	// goals against
+	// Required: true
+	GoalsAgainst *int64 `json:"goalsAgainst"`
+
+	// goals for
+	// Required: true
+	GoalsFor *int64 `json:"goalsFor"`
+
 	// lost
 	// Required: true
 	Lost *int64 `json:"lost"`
 
+	// Losses in extra time or by penalties
+	OvertimeLost int64 `json:"overtimeLost,omitempty"`
+
+	// Wins in extra time or by penalties
+	OvertimeWon int64 `json:"overtimeWon,omitempty"`
+
 	// played
 	// Required: true
 	Played *int64 `json:"played"`
 
-	// points
+	// Final points, basePoints plus adjustment
 	// Required: true
 	Points *int64 `json:"points"`
 
+	// Wins in regulation time
+	RegulationWon int64 `json:"regulationWon,omitempty"`
+
 	// team
 	// Required: true
 	// Min Length: 1
@@ -43,6 +77,10 @@ type Stats struct {
 	// won
 	// Required: true
 	Won *int64 `json:"won"`
+
+	// Zone of the team in the ranked standings
+	// Enum: [promotion promotion-playoff relegation-playoff relegation]
+	Zone string `json:"zone,omitempty"`
 }
 
 // Validate validates this stats
@@ -53,6 +91,18 @@ func (m *Stats) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateGoalDifference(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateGoalsAgainst(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateGoalsFor(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateLost(formats); err != nil {
 		res = append(res, err)
 	}
@@ -73,6 +123,10 @@ func (m *Stats) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateZone(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -88,6 +142,33 @@ func (m *Stats) validateDrawn(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Stats) validateGoalDifference(formats strfmt.Registry) error {
+
+	if err := validate.Required("goalDifference", "body", m.GoalDifference); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Stats) validateGoalsAgainst(formats strfmt.Registry) error {
+
+	if err := validate.Required("goalsAgainst", "body", m.GoalsAgainst); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Stats) validateGoalsFor(formats strfmt.Registry) error {
+
+	if err := validate.Required("goalsFor", "body", m.GoalsFor); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Stats) validateLost(formats strfmt.Registry) error {
 
 	if err := validate.Required("lost", "body", m.Lost); err != nil {
@@ -137,6 +218,54 @@ func (m *Stats) validateWon(formats strfmt.Registry) error {
 	return nil
 }
 
+var statsTypeZonePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["promotion","promotion-playoff","relegation-playoff","relegation"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		statsTypeZonePropEnum = append(statsTypeZonePropEnum, v)
+	}
+}
+
+const (
+
+	// StatsZonePromotion captures enum value "promotion"
+	StatsZonePromotion string = "promotion"
+
+	// StatsZonePromotionDashPlayoff captures enum value "promotion-playoff"
+	StatsZonePromotionDashPlayoff string = "promotion-playoff"
+
+	// StatsZoneRelegationDashPlayoff captures enum value "relegation-playoff"
+	StatsZoneRelegationDashPlayoff string = "relegation-playoff"
+
+	// StatsZoneRelegation captures enum value "relegation"
+	StatsZoneRelegation string = "relegation"
+)
+
+// prop value enum
+func (m *Stats) validateZoneEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, statsTypeZonePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Stats) validateZone(formats strfmt.Registry) error {
+	if swag.IsZero(m.Zone) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateZoneEnum("zone", "body", m.Zone); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validates this stats based on context it is used
 func (m *Stats) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
@@ -51,21 +51,251 @@ func configureAPI(api *operations.TournamentAPI) http.Handler {
 	// Example:
 	// api.APIAuthorizer = security.Authorized()
 
+	if api.AdjustPointsHandler == nil {
+		api.AdjustPointsHandler = operations.AdjustPointsHandlerFunc(func(params operations.AdjustPointsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.AdjustPoints has not yet been implemented")
+		})
+	}
+	if api.CloseSeasonHandler == nil {
+		api.CloseSeasonHandler = operations.CloseSeasonHandlerFunc(func(params operations.CloseSeasonParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.CloseSeason has not yet been implemented")
+		})
+	}
+	if api.CreateBracketHandler == nil {
+		api.CreateBracketHandler = operations.CreateBracketHandlerFunc(func(params operations.CreateBracketParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.CreateBracket has not yet been implemented")
+		})
+	}
+	if api.CreateCompetitionHandler == nil {
+		api.CreateCompetitionHandler = operations.CreateCompetitionHandlerFunc(func(params operations.CreateCompetitionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.CreateCompetition has not yet been implemented")
+		})
+	}
+	if api.CreateGroupStageHandler == nil {
+		api.CreateGroupStageHandler = operations.CreateGroupStageHandlerFunc(func(params operations.CreateGroupStageParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.CreateGroupStage has not yet been implemented")
+		})
+	}
+	if api.CreateTeamHandler == nil {
+		api.CreateTeamHandler = operations.CreateTeamHandlerFunc(func(params operations.CreateTeamParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.CreateTeam has not yet been implemented")
+		})
+	}
+	if api.CreateWebhookHandler == nil {
+		api.CreateWebhookHandler = operations.CreateWebhookHandlerFunc(func(params operations.CreateWebhookParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.CreateWebhook has not yet been implemented")
+		})
+	}
+	if api.DeleteGameHandler == nil {
+		api.DeleteGameHandler = operations.DeleteGameHandlerFunc(func(params operations.DeleteGameParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.DeleteGame has not yet been implemented")
+		})
+	}
+	if api.DeleteTeamHandler == nil {
+		api.DeleteTeamHandler = operations.DeleteTeamHandlerFunc(func(params operations.DeleteTeamParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.DeleteTeam has not yet been implemented")
+		})
+	}
+	if api.DeleteWebhookHandler == nil {
+		api.DeleteWebhookHandler = operations.DeleteWebhookHandlerFunc(func(params operations.DeleteWebhookParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.DeleteWebhook has not yet been implemented")
+		})
+	}
 	if api.GetAllStatsHandler == nil {
 		api.GetAllStatsHandler = operations.GetAllStatsHandlerFunc(func(params operations.GetAllStatsParams) middleware.Responder {
 			return middleware.NotImplemented("operation operations.GetAllStats has not yet been implemented")
 		})
 	}
+	if api.GetAuditTrailHandler == nil {
+		api.GetAuditTrailHandler = operations.GetAuditTrailHandlerFunc(func(params operations.GetAuditTrailParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.GetAuditTrail has not yet been implemented")
+		})
+	}
+	if api.GetBracketHandler == nil {
+		api.GetBracketHandler = operations.GetBracketHandlerFunc(func(params operations.GetBracketParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.GetBracket has not yet been implemented")
+		})
+	}
+	if api.GetCompetitionHandler == nil {
+		api.GetCompetitionHandler = operations.GetCompetitionHandlerFunc(func(params operations.GetCompetitionParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.GetCompetition has not yet been implemented")
+		})
+	}
+	if api.GetCompetitionFixturesHandler == nil {
+		api.GetCompetitionFixturesHandler = operations.GetCompetitionFixturesHandlerFunc(func(params operations.GetCompetitionFixturesParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.GetCompetitionFixtures has not yet been implemented")
+		})
+	}
+	if api.GetCompetitionMovementsHandler == nil {
+		api.GetCompetitionMovementsHandler = operations.GetCompetitionMovementsHandlerFunc(func(params operations.GetCompetitionMovementsParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.GetCompetitionMovements has not yet been implemented")
+		})
+	}
+	if api.GetCompetitionStatsHandler == nil {
+		api.GetCompetitionStatsHandler = operations.GetCompetitionStatsHandlerFunc(func(params operations.GetCompetitionStatsParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.GetCompetitionStats has not yet been implemented")
+		})
+	}
+	if api.GetCompetitionTeamStatsHandler == nil {
+		api.GetCompetitionTeamStatsHandler = operations.GetCompetitionTeamStatsHandlerFunc(func(params operations.GetCompetitionTeamStatsParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.GetCompetitionTeamStats has not yet been implemented")
+		})
+	}
+	if api.GetFixturesHandler == nil {
+		api.GetFixturesHandler = operations.GetFixturesHandlerFunc(func(params operations.GetFixturesParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.GetFixtures has not yet been implemented")
+		})
+	}
+	if api.GetGameHandler == nil {
+		api.GetGameHandler = operations.GetGameHandlerFunc(func(params operations.GetGameParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.GetGame has not yet been implemented")
+		})
+	}
+	if api.GetGameHistoryHandler == nil {
+		api.GetGameHistoryHandler = operations.GetGameHistoryHandlerFunc(func(params operations.GetGameHistoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.GetGameHistory has not yet been implemented")
+		})
+	}
+	if api.GetGroupStageHandler == nil {
+		api.GetGroupStageHandler = operations.GetGroupStageHandlerFunc(func(params operations.GetGroupStageParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.GetGroupStage has not yet been implemented")
+		})
+	}
+	if api.GetHeadToHeadStatsHandler == nil {
+		api.GetHeadToHeadStatsHandler = operations.GetHeadToHeadStatsHandlerFunc(func(params operations.GetHeadToHeadStatsParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.GetHeadToHeadStats has not yet been implemented")
+		})
+	}
+	if api.GetLiveFeedHandler == nil {
+		api.GetLiveFeedHandler = operations.GetLiveFeedHandlerFunc(func(params operations.GetLiveFeedParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.GetLiveFeed has not yet been implemented")
+		})
+	}
+	if api.GetStatsStreamHandler == nil {
+		api.GetStatsStreamHandler = operations.GetStatsStreamHandlerFunc(func(params operations.GetStatsStreamParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.GetStatsStream has not yet been implemented")
+		})
+	}
+	if api.GetTeamHandler == nil {
+		api.GetTeamHandler = operations.GetTeamHandlerFunc(func(params operations.GetTeamParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.GetTeam has not yet been implemented")
+		})
+	}
+	if api.GetTeamAdjustmentsHandler == nil {
+		api.GetTeamAdjustmentsHandler = operations.GetTeamAdjustmentsHandlerFunc(func(params operations.GetTeamAdjustmentsParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.GetTeamAdjustments has not yet been implemented")
+		})
+	}
+	if api.GetTeamHistoryHandler == nil {
+		api.GetTeamHistoryHandler = operations.GetTeamHistoryHandlerFunc(func(params operations.GetTeamHistoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.GetTeamHistory has not yet been implemented")
+		})
+	}
 	if api.GetTeamStatsHandler == nil {
 		api.GetTeamStatsHandler = operations.GetTeamStatsHandlerFunc(func(params operations.GetTeamStatsParams) middleware.Responder {
 			return middleware.NotImplemented("operation operations.GetTeamStats has not yet been implemented")
 		})
 	}
+	if api.GetWebhookHandler == nil {
+		api.GetWebhookHandler = operations.GetWebhookHandlerFunc(func(params operations.GetWebhookParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.GetWebhook has not yet been implemented")
+		})
+	}
+	if api.GetWebhookDeliveriesHandler == nil {
+		api.GetWebhookDeliveriesHandler = operations.GetWebhookDeliveriesHandlerFunc(func(params operations.GetWebhookDeliveriesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.GetWebhookDeliveries has not yet been implemented")
+		})
+	}
+	if api.ListCompetitionsHandler == nil {
+		api.ListCompetitionsHandler = operations.ListCompetitionsHandlerFunc(func(params operations.ListCompetitionsParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.ListCompetitions has not yet been implemented")
+		})
+	}
+	if api.ListGamesHandler == nil {
+		api.ListGamesHandler = operations.ListGamesHandlerFunc(func(params operations.ListGamesParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.ListGames has not yet been implemented")
+		})
+	}
+	if api.ListTeamsHandler == nil {
+		api.ListTeamsHandler = operations.ListTeamsHandlerFunc(func(params operations.ListTeamsParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.ListTeams has not yet been implemented")
+		})
+	}
+	if api.ListWebhooksHandler == nil {
+		api.ListWebhooksHandler = operations.ListWebhooksHandlerFunc(func(params operations.ListWebhooksParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.ListWebhooks has not yet been implemented")
+		})
+	}
+	if api.MergeTeamsHandler == nil {
+		api.MergeTeamsHandler = operations.MergeTeamsHandlerFunc(func(params operations.MergeTeamsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.MergeTeams has not yet been implemented")
+		})
+	}
+	if api.PairSwissRoundHandler == nil {
+		api.PairSwissRoundHandler = operations.PairSwissRoundHandlerFunc(func(params operations.PairSwissRoundParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.PairSwissRound has not yet been implemented")
+		})
+	}
+	if api.PatchGameHandler == nil {
+		api.PatchGameHandler = operations.PatchGameHandlerFunc(func(params operations.PatchGameParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.PatchGame has not yet been implemented")
+		})
+	}
 	if api.PlayHandler == nil {
 		api.PlayHandler = operations.PlayHandlerFunc(func(params operations.PlayParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation operations.Play has not yet been implemented")
 		})
 	}
+	if api.PlayInCompetitionHandler == nil {
+		api.PlayInCompetitionHandler = operations.PlayInCompetitionHandlerFunc(func(params operations.PlayInCompetitionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.PlayInCompetition has not yet been implemented")
+		})
+	}
+	if api.RecordFixtureResultHandler == nil {
+		api.RecordFixtureResultHandler = operations.RecordFixtureResultHandlerFunc(func(params operations.RecordFixtureResultParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.RecordFixtureResult has not yet been implemented")
+		})
+	}
+	if api.RenameTeamHandler == nil {
+		api.RenameTeamHandler = operations.RenameTeamHandlerFunc(func(params operations.RenameTeamParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.RenameTeam has not yet been implemented")
+		})
+	}
+	if api.RetryWebhookDeliveryHandler == nil {
+		api.RetryWebhookDeliveryHandler = operations.RetryWebhookDeliveryHandlerFunc(func(params operations.RetryWebhookDeliveryParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.RetryWebhookDelivery has not yet been implemented")
+		})
+	}
+	if api.RolloverSeasonHandler == nil {
+		api.RolloverSeasonHandler = operations.RolloverSeasonHandlerFunc(func(params operations.RolloverSeasonParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.RolloverSeason has not yet been implemented")
+		})
+	}
+	if api.ScheduleFixturesHandler == nil {
+		api.ScheduleFixturesHandler = operations.ScheduleFixturesHandlerFunc(func(params operations.ScheduleFixturesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.ScheduleFixtures has not yet been implemented")
+		})
+	}
+	if api.UpdateFixtureStatusHandler == nil {
+		api.UpdateFixtureStatusHandler = operations.UpdateFixtureStatusHandlerFunc(func(params operations.UpdateFixtureStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.UpdateFixtureStatus has not yet been implemented")
+		})
+	}
+	if api.UpdateGameHandler == nil {
+		api.UpdateGameHandler = operations.UpdateGameHandlerFunc(func(params operations.UpdateGameParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.UpdateGame has not yet been implemented")
+		})
+	}
+	if api.UpdateTeamHandler == nil {
+		api.UpdateTeamHandler = operations.UpdateTeamHandlerFunc(func(params operations.UpdateTeamParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.UpdateTeam has not yet been implemented")
+		})
+	}
+	if api.UpdateWebhookHandler == nil {
+		api.UpdateWebhookHandler = operations.UpdateWebhookHandlerFunc(func(params operations.UpdateWebhookParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation operations.UpdateWebhook has not yet been implemented")
+		})
+	}
 
 	api.PreServerShutdown = func() {}
 
@@ -33,26 +33,79 @@ func init() {
     "version": "1.0.0"
   },
   "paths": {
-    "/games": {
+    "/audit": {
+      "get": {
+        "operationId": "getAuditTrail",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Competition of the games, the default competition when not set",
+            "name": "competition",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "principal",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Lists the changes older than the change with this ID",
+            "name": "before",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Changes made to games, the latest first",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/auditEntry"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/brackets": {
       "post": {
         "security": [
           {
             "key": []
           }
         ],
-        "operationId": "play",
+        "operationId": "createBracket",
         "parameters": [
           {
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/game"
+              "$ref": "#/definitions/newBracket"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "Created"
+            "description": "Created bracket",
+            "schema": {
+              "$ref": "#/definitions/bracket"
+            }
           },
           "default": {
             "description": "Error",
@@ -63,17 +116,22 @@ func init() {
         }
       }
     },
-    "/stats": {
+    "/brackets/{id}": {
       "get": {
-        "operationId": "getAllStats",
+        "operationId": "getBracket",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
-            "description": "List all teams statistics",
+            "description": "Get bracket",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/stats"
-              }
+              "$ref": "#/definitions/bracket"
             }
           },
           "default": {
@@ -85,22 +143,49 @@ func init() {
         }
       }
     },
-    "/stats/{team}": {
+    "/competitions": {
       "get": {
-        "operationId": "getTeamStats",
+        "operationId": "listCompetitions",
+        "responses": {
+          "200": {
+            "description": "List all competitions",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/competition"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "createCompetition",
         "parameters": [
           {
-            "type": "string",
-            "name": "team",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/newCompetition"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Get team stats",
+          "201": {
+            "description": "Created competition",
             "schema": {
-              "$ref": "#/definitions/stats"
+              "$ref": "#/definitions/competition"
             }
           },
           "default": {
@@ -111,129 +196,34 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "error": {
-      "type": "object",
-      "required": [
-        "message"
-      ],
-      "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "message": {
-          "type": "string"
-        }
-      }
-    },
-    "game": {
-      "type": "object",
-      "required": [
-        "teamA",
-        "scoreA",
-        "teamB",
-        "scoreB"
-      ],
-      "properties": {
-        "scoreA": {
-          "type": "integer"
-        },
-        "scoreB": {
-          "type": "integer"
-        },
-        "teamA": {
-          "type": "string",
-          "minLength": 1
-        },
-        "teamB": {
-          "type": "string",
-          "minLength": 1
-        }
-      }
     },
-    "principal": {
-      "type": "string"
-    },
-    "stats": {
-      "type": "object",
-      "required": [
-        "team",
-        "played",
-        "won",
-        "drawn",
-        "lost",
-        "points"
-      ],
-      "properties": {
-        "drawn": {
-          "type": "integer"
-        },
-        "lost": {
-          "type": "integer"
-        },
-        "played": {
-          "type": "integer"
-        },
-        "points": {
-          "type": "integer"
-        },
-        "team": {
-          "type": "string",
-          "minLength": 1
-        },
-        "won": {
-          "type": "integer"
-        }
-      }
-    }
-  },
-  "securityDefinitions": {
-    "key": {
-      "type": "apiKey",
-      "name": "x-token",
-      "in": "header"
-    }
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/slawekzachcial.tournament.v1+json"
-  ],
-  "produces": [
-    "application/slawekzachcial.tournament.v1+json"
-  ],
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "title": "Tournament",
-    "version": "1.0.0"
-  },
-  "paths": {
-    "/games": {
+    "/competitions/rollover": {
       "post": {
         "security": [
           {
             "key": []
           }
         ],
-        "operationId": "play",
+        "operationId": "rolloverSeason",
         "parameters": [
           {
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/game"
+              "$ref": "#/definitions/rollover"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "Created"
+            "description": "Competitions of the new season, from the top division",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/competition"
+              }
+            }
           },
           "default": {
             "description": "Error",
@@ -244,12 +234,52 @@ func init() {
         }
       }
     },
-    "/stats": {
+    "/competitions/{id}": {
       "get": {
-        "operationId": "getAllStats",
+        "operationId": "getCompetition",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
-            "description": "List all teams statistics",
+            "description": "Get competition",
+            "schema": {
+              "$ref": "#/definitions/competition"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/competitions/{id}/close": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "closeSeason",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Final standings of the season",
             "schema": {
               "type": "array",
               "items": {
@@ -266,22 +296,39 @@ func init() {
         }
       }
     },
-    "/stats/{team}": {
+    "/competitions/{id}/fixtures": {
       "get": {
-        "operationId": "getTeamStats",
+        "operationId": "getCompetitionFixtures",
         "parameters": [
           {
-            "type": "string",
-            "name": "team",
+            "type": "integer",
+            "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "scheduled",
+              "inProgress",
+              "completed",
+              "postponed",
+              "cancelled",
+              "abandoned"
+            ],
+            "type": "string",
+            "description": "Lists only the fixtures with the status",
+            "name": "status",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Get team stats",
+            "description": "List all fixtures of the competition",
             "schema": {
-              "$ref": "#/definitions/stats"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/fixture"
+              }
             }
           },
           "default": {
@@ -292,52 +339,5106 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "error": {
-      "type": "object",
-      "required": [
-        "message"
-      ],
-      "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "message": {
-          "type": "string"
-        }
-      }
+    },
+    "/competitions/{id}/games": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "playInCompetition",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/game"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/game"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/competitions/{id}/movements": {
+      "get": {
+        "description": "Teams in the promotion and relegation zones, based on the final standings once the season is closed",
+        "operationId": "getCompetitionMovements",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Teams leaving the division by zone",
+            "schema": {
+              "$ref": "#/definitions/movements"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/competitions/{id}/stats": {
+      "get": {
+        "operationId": "getCompetitionStats",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "List all teams statistics of the competition",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/stats"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/competitions/{id}/stats/{team}": {
+      "get": {
+        "operationId": "getCompetitionTeamStats",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "team",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get team stats in the competition",
+            "schema": {
+              "$ref": "#/definitions/stats"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/fixtures": {
+      "get": {
+        "operationId": "getFixtures",
+        "parameters": [
+          {
+            "enum": [
+              "scheduled",
+              "inProgress",
+              "completed",
+              "postponed",
+              "cancelled",
+              "abandoned"
+            ],
+            "type": "string",
+            "description": "Lists only the fixtures with the status",
+            "name": "status",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "List all fixtures",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/fixture"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "scheduleFixtures",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/schedule"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created fixtures",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/fixture"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/fixtures/swiss": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "description": "Pairs the next round of a Swiss-system tournament once all games of the previous round are played",
+        "operationId": "pairSwissRound",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/swissRound"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created fixtures of the round",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/fixture"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/fixtures/{id}": {
+      "patch": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "updateFixtureStatus",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/fixturePatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated fixture",
+            "schema": {
+              "$ref": "#/definitions/fixture"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/fixtures/{id}/result": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "description": "Records the game of the fixture and completes it, or corrects the game of a completed fixture",
+        "operationId": "recordFixtureResult",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/fixtureResult"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Recorded game",
+            "schema": {
+              "$ref": "#/definitions/game"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/games": {
+      "get": {
+        "operationId": "listGames",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Competition of the games, the default competition when not set",
+            "name": "competition",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "team",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Opponent of the team",
+            "name": "opponent",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "win",
+              "draw",
+              "loss"
+            ],
+            "type": "string",
+            "description": "Result of the games for the team",
+            "name": "result",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Games played at or after this time",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Games played before this time",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "playedAt",
+              "recordedAt"
+            ],
+            "type": "string",
+            "default": "playedAt",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "asc",
+              "desc"
+            ],
+            "type": "string",
+            "default": "asc",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "nextCursor of the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "maximum": 500,
+            "minimum": 1,
+            "type": "integer",
+            "default": 50,
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Page of games",
+            "schema": {
+              "$ref": "#/definitions/gamePage"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "play",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/game"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/game"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/games/{id}": {
+      "get": {
+        "operationId": "getGame",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get game",
+            "schema": {
+              "$ref": "#/definitions/game"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "updateGame",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/game"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated game",
+            "schema": {
+              "$ref": "#/definitions/game"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "deleteGame",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "patchGame",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/gamePatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated game",
+            "schema": {
+              "$ref": "#/definitions/game"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/games/{id}/history": {
+      "get": {
+        "operationId": "getGameHistory",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Changes made to the game, the oldest first",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/auditEntry"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/group-stages": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "createGroupStage",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/newGroupStage"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created group stage",
+            "schema": {
+              "$ref": "#/definitions/groupStage"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/group-stages/{id}": {
+      "get": {
+        "operationId": "getGroupStage",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get group stage with group standings",
+            "schema": {
+              "$ref": "#/definitions/groupStage"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/live": {
+      "get": {
+        "description": "WebSocket feed of the games being played. Subscribers receive liveMessage updates, and scorekeepers authenticated with x-token send liveCommand messages.",
+        "operationId": "getLiveFeed",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "API key of scorekeepers",
+            "name": "x-token",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "101": {
+            "description": "Switching to the WebSocket live feed"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/stats": {
+      "get": {
+        "operationId": "getAllStats",
+        "responses": {
+          "200": {
+            "description": "List all teams statistics",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/stats"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/stats/head-to-head": {
+      "get": {
+        "operationId": "getHeadToHeadStats",
+        "parameters": [
+          {
+            "minItems": 2,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "csv",
+            "name": "teams",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "List teams statistics from the games played between them",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/stats"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/stats/stream": {
+      "get": {
+        "produces": [
+          "text/event-stream"
+        ],
+        "operationId": "getStatsStream",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ID of the last event received before reconnecting",
+            "name": "Last-Event-ID",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Server-Sent Events with all teams statistics, sent on connection and whenever they change",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/stats/{team}": {
+      "get": {
+        "operationId": "getTeamStats",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "team",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get team stats",
+            "schema": {
+              "$ref": "#/definitions/stats"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/stats/{team}/adjustments": {
+      "get": {
+        "operationId": "getTeamAdjustments",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "team",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Adjustments of the team points, the oldest first",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/pointAdjustment"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "adjustPoints",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "team",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pointAdjustment"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Adjustment added to the team points",
+            "schema": {
+              "$ref": "#/definitions/pointAdjustment"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/teams": {
+      "get": {
+        "operationId": "listTeams",
+        "responses": {
+          "200": {
+            "description": "List all teams",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/team"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "createTeam",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/team"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created team",
+            "schema": {
+              "$ref": "#/definitions/team"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/teams/{id}": {
+      "get": {
+        "operationId": "getTeam",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get team",
+            "schema": {
+              "$ref": "#/definitions/team"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "updateTeam",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/team"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated team",
+            "schema": {
+              "$ref": "#/definitions/team"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "deleteTeam",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/teams/{id}/history": {
+      "get": {
+        "operationId": "getTeamHistory",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Renames of the team and merges into it, the oldest first",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/teamChange"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/teams/{id}/merge": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "mergeTeams",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/teamMerge"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Team with the games and names of the merged team",
+            "schema": {
+              "$ref": "#/definitions/team"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/teams/{id}/rename": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "renameTeam",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/teamRename"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Renamed team, keeping the old name as alias",
+            "schema": {
+              "$ref": "#/definitions/team"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks": {
+      "get": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "listWebhooks",
+        "responses": {
+          "200": {
+            "description": "List all webhooks, without their secrets",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/webhook"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "createWebhook",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created webhook, with its secret",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/deliveries/{id}/retry": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "retryWebhookDelivery",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Dead delivery made pending again",
+            "schema": {
+              "$ref": "#/definitions/webhookDelivery"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/{id}": {
+      "get": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "getWebhook",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get webhook, without its secret",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "updateWebhook",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated webhook, without its secret",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "deleteWebhook",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Deleted, along with its deliveries"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/{id}/deliveries": {
+      "get": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "getWebhookDeliveries",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "pending",
+              "delivered",
+              "dead"
+            ],
+            "type": "string",
+            "description": "Lists the dead deliveries only when dead",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Deliveries of events to the webhook with their attempts, the latest first",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/webhookDelivery"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "auditEntry": {
+      "type": "object",
+      "required": [
+        "id",
+        "gameId",
+        "action",
+        "principal",
+        "requestId",
+        "at"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "create",
+            "update",
+            "delete"
+          ]
+        },
+        "after": {
+          "$ref": "#/definitions/game"
+        },
+        "at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "before": {
+          "$ref": "#/definitions/game"
+        },
+        "gameId": {
+          "type": "integer"
+        },
+        "id": {
+          "type": "integer"
+        },
+        "principal": {
+          "type": "string"
+        },
+        "requestId": {
+          "type": "string"
+        }
+      }
+    },
+    "bracket": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "final"
+      ],
+      "properties": {
+        "final": {
+          "$ref": "#/definitions/bracketMatch"
+        },
+        "id": {
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "bracketMatch": {
+      "type": "object",
+      "required": [
+        "round",
+        "position"
+      ],
+      "properties": {
+        "position": {
+          "type": "integer"
+        },
+        "previous": {
+          "description": "Matches whose winners play this match",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bracketMatch"
+          }
+        },
+        "round": {
+          "type": "integer"
+        },
+        "teamA": {
+          "type": "string"
+        },
+        "teamB": {
+          "type": "string"
+        },
+        "winner": {
+          "type": "string"
+        }
+      }
+    },
+    "competition": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "season",
+        "format",
+        "scoring"
+      ],
+      "properties": {
+        "closed": {
+          "description": "Closed seasons no longer accept games and keep their final standings",
+          "type": "boolean"
+        },
+        "format": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
+        },
+        "scoring": {
+          "$ref": "#/definitions/scoringRules"
+        },
+        "season": {
+          "type": "string"
+        },
+        "teams": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "zones": {
+          "$ref": "#/definitions/zones"
+        }
+      }
+    },
+    "deliveryAttempt": {
+      "type": "object",
+      "required": [
+        "attempt",
+        "durationMs",
+        "at"
+      ],
+      "properties": {
+        "at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "attempt": {
+          "type": "integer"
+        },
+        "durationMs": {
+          "type": "integer"
+        },
+        "error": {
+          "type": "string"
+        },
+        "statusCode": {
+          "description": "Not set when no response was received",
+          "type": "integer"
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "fields": {
+          "description": "Errors of the invalid fields",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/fieldError"
+          }
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "fieldError": {
+      "type": "object",
+      "required": [
+        "field",
+        "message"
+      ],
+      "properties": {
+        "field": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "fixture": {
+      "type": "object",
+      "required": [
+        "round",
+        "homeTeam"
+      ],
+      "properties": {
+        "awayTeam": {
+          "description": "Not set when homeTeam has a bye",
+          "type": "string"
+        },
+        "gameId": {
+          "description": "Game recorded as the result, counted in the stats while the fixture is completed",
+          "type": "integer",
+          "readOnly": true
+        },
+        "homeTeam": {
+          "type": "string",
+          "minLength": 1
+        },
+        "id": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "round": {
+          "type": "integer"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "scheduled",
+            "inProgress",
+            "completed",
+            "postponed",
+            "cancelled",
+            "abandoned"
+          ],
+          "readOnly": true
+        }
+      }
+    },
+    "fixturePatch": {
+      "type": "object",
+      "required": [
+        "status"
+      ],
+      "properties": {
+        "status": {
+          "type": "string",
+          "enum": [
+            "scheduled",
+            "inProgress",
+            "postponed",
+            "cancelled",
+            "abandoned"
+          ]
+        }
+      }
+    },
+    "fixtureResult": {
+      "type": "object",
+      "required": [
+        "homeScore",
+        "awayScore"
+      ],
+      "properties": {
+        "awayPenalties": {
+          "type": "integer"
+        },
+        "awayScore": {
+          "type": "integer"
+        },
+        "decidedIn": {
+          "type": "string",
+          "default": "regulation",
+          "enum": [
+            "regulation",
+            "extraTime",
+            "penalties"
+          ]
+        },
+        "homePenalties": {
+          "type": "integer"
+        },
+        "homeScore": {
+          "type": "integer"
+        },
+        "outcome": {
+          "type": "string",
+          "default": "played",
+          "enum": [
+            "played",
+            "forfeit",
+            "walkover",
+            "doubleForfeit"
+          ]
+        },
+        "playedAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "game": {
+      "type": "object",
+      "required": [
+        "teamA",
+        "scoreA",
+        "teamB",
+        "scoreB"
+      ],
+      "properties": {
+        "decidedIn": {
+          "description": "Period in which a game that needed a winner was settled",
+          "type": "string",
+          "default": "regulation",
+          "enum": [
+            "regulation",
+            "extraTime",
+            "penalties"
+          ]
+        },
+        "id": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "outcome": {
+          "description": "Whether the game was played or its result awarded, the awarded scores naming the winner of a forfeit or walkover",
+          "type": "string",
+          "default": "played",
+          "enum": [
+            "played",
+            "forfeit",
+            "walkover",
+            "doubleForfeit"
+          ]
+        },
+        "penaltiesA": {
+          "type": "integer"
+        },
+        "penaltiesB": {
+          "type": "integer"
+        },
+        "playedAt": {
+          "description": "When the game was played, the time it is recorded when not set",
+          "type": "string",
+          "format": "date-time"
+        },
+        "recordedAt": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "scoreA": {
+          "type": "integer"
+        },
+        "scoreB": {
+          "type": "integer"
+        },
+        "teamA": {
+          "type": "string",
+          "minLength": 1
+        },
+        "teamB": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "gamePage": {
+      "type": "object",
+      "required": [
+        "games"
+      ],
+      "properties": {
+        "games": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/game"
+          }
+        },
+        "nextCursor": {
+          "description": "Cursor of the next page, not set on the last page",
+          "type": "string"
+        }
+      }
+    },
+    "gamePatch": {
+      "description": "Fields of the game to change",
+      "type": "object",
+      "properties": {
+        "decidedIn": {
+          "type": "string",
+          "enum": [
+            "regulation",
+            "extraTime",
+            "penalties"
+          ],
+          "x-nullable": true
+        },
+        "outcome": {
+          "type": "string",
+          "enum": [
+            "played",
+            "forfeit",
+            "walkover",
+            "doubleForfeit"
+          ],
+          "x-nullable": true
+        },
+        "penaltiesA": {
+          "type": "integer",
+          "x-nullable": true
+        },
+        "penaltiesB": {
+          "type": "integer",
+          "x-nullable": true
+        },
+        "playedAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "scoreA": {
+          "type": "integer",
+          "x-nullable": true
+        },
+        "scoreB": {
+          "type": "integer",
+          "x-nullable": true
+        },
+        "teamA": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": true
+        },
+        "teamB": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": true
+        }
+      }
+    },
+    "group": {
+      "type": "object",
+      "required": [
+        "name",
+        "teams"
+      ],
+      "properties": {
+        "name": {
+          "type": "string",
+          "minLength": 1
+        },
+        "teams": {
+          "type": "array",
+          "minItems": 2,
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        }
+      }
+    },
+    "groupStage": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "qualifiers",
+        "groups"
+      ],
+      "properties": {
+        "bracketId": {
+          "description": "Knockout bracket, set once all group games are played",
+          "type": "integer"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/groupTable"
+          }
+        },
+        "id": {
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
+        },
+        "qualifiers": {
+          "type": "integer"
+        }
+      }
+    },
+    "groupTable": {
+      "type": "object",
+      "required": [
+        "name",
+        "standings"
+      ],
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "standings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/stats"
+          }
+        }
+      }
+    },
+    "liveCommand": {
+      "type": "object",
+      "required": [
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "start",
+            "score",
+            "end",
+            "cancel"
+          ]
+        },
+        "game": {
+          "$ref": "#/definitions/game"
+        },
+        "id": {
+          "description": "ID of the live game, not set to start a game",
+          "type": "integer"
+        }
+      }
+    },
+    "liveGame": {
+      "type": "object",
+      "required": [
+        "id",
+        "game"
+      ],
+      "properties": {
+        "game": {
+          "$ref": "#/definitions/game"
+        },
+        "id": {
+          "description": "ID of the live game, the recorded game gets its own ID when the game ends",
+          "type": "integer"
+        },
+        "startedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "liveMessage": {
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "error": {
+          "$ref": "#/definitions/error"
+        },
+        "liveGame": {
+          "$ref": "#/definitions/liveGame"
+        },
+        "liveGames": {
+          "description": "Games being played, in a snapshot sent on connection",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/liveGame"
+          }
+        },
+        "result": {
+          "$ref": "#/definitions/game"
+        },
+        "standings": {
+          "description": "Provisional standings including the games being played",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/stats"
+          }
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "snapshot",
+            "started",
+            "updated",
+            "ended",
+            "cancelled",
+            "standings",
+            "error"
+          ]
+        }
+      }
+    },
+    "movements": {
+      "type": "object",
+      "properties": {
+        "promoted": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "promotionPlayoff": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "relegated": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "relegationPlayoff": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "newBracket": {
+      "type": "object",
+      "required": [
+        "name",
+        "teams"
+      ],
+      "properties": {
+        "name": {
+          "type": "string",
+          "minLength": 1
+        },
+        "teams": {
+          "description": "Teams from the top seed",
+          "type": "array",
+          "minItems": 2,
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        }
+      }
+    },
+    "newCompetition": {
+      "type": "object",
+      "required": [
+        "name",
+        "format"
+      ],
+      "properties": {
+        "format": {
+          "type": "string",
+          "enum": [
+            "league",
+            "knockout",
+            "swiss",
+            "groups"
+          ]
+        },
+        "name": {
+          "type": "string",
+          "minLength": 1
+        },
+        "scoring": {
+          "$ref": "#/definitions/scoringRules"
+        },
+        "season": {
+          "type": "string"
+        },
+        "teams": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        },
+        "zones": {
+          "$ref": "#/definitions/zones"
+        }
+      }
+    },
+    "newGroupStage": {
+      "type": "object",
+      "required": [
+        "name",
+        "groups",
+        "qualifiers"
+      ],
+      "properties": {
+        "groups": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/group"
+          }
+        },
+        "name": {
+          "type": "string",
+          "minLength": 1
+        },
+        "qualifiers": {
+          "description": "Number of best placed teams of every group qualifying for the knockout bracket",
+          "type": "integer",
+          "minimum": 1
+        }
+      }
+    },
+    "pointAdjustment": {
+      "type": "object",
+      "required": [
+        "delta",
+        "reason"
+      ],
+      "properties": {
+        "at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "delta": {
+          "description": "Points added to the team, or deducted when negative",
+          "type": "integer"
+        },
+        "id": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "principal": {
+          "type": "string",
+          "readOnly": true
+        },
+        "reason": {
+          "type": "string",
+          "maxLength": 200,
+          "minLength": 1
+        },
+        "requestId": {
+          "type": "string",
+          "readOnly": true
+        },
+        "team": {
+          "type": "string",
+          "readOnly": true
+        }
+      }
+    },
+    "principal": {
+      "type": "string"
+    },
+    "rollover": {
+      "type": "object",
+      "required": [
+        "season",
+        "divisions"
+      ],
+      "properties": {
+        "divisions": {
+          "description": "IDs of the closed competitions, from the top division",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "integer"
+          }
+        },
+        "playoffMovers": {
+          "description": "Teams from playoff places which won promotion or lost relegation in the playoffs",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "promotions": {
+          "description": "Number of teams promoted from every division without zones and relegated from the one above",
+          "type": "integer"
+        },
+        "season": {
+          "type": "string"
+        }
+      }
+    },
+    "schedule": {
+      "type": "object",
+      "required": [
+        "teams"
+      ],
+      "properties": {
+        "double": {
+          "description": "Play every pairing twice, home and away",
+          "type": "boolean"
+        },
+        "teams": {
+          "type": "array",
+          "minItems": 2,
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        }
+      }
+    },
+    "scoringRules": {
+      "type": "object",
+      "required": [
+        "win",
+        "draw",
+        "loss"
+      ],
+      "properties": {
+        "bigWinBonus": {
+          "type": "integer"
+        },
+        "bigWinMargin": {
+          "description": "Goal margin from which a win earns bigWinBonus points, 0 disables the bonus",
+          "type": "integer"
+        },
+        "draw": {
+          "type": "integer"
+        },
+        "forfeitDeduction": {
+          "description": "Points taken from a team losing by forfeit",
+          "type": "integer"
+        },
+        "loss": {
+          "type": "integer"
+        },
+        "overtime": {
+          "description": "Scores the games decided in extra time or by penalties with overtimeWin and overtimeLoss points, otherwise a game decided by penalties is a draw",
+          "type": "boolean"
+        },
+        "overtimeLoss": {
+          "type": "integer"
+        },
+        "overtimeWin": {
+          "type": "integer"
+        },
+        "win": {
+          "type": "integer"
+        }
+      }
+    },
+    "stats": {
+      "type": "object",
+      "required": [
+        "team",
+        "played",
+        "won",
+        "drawn",
+        "lost",
+        "goalsFor",
+        "goalsAgainst",
+        "goalDifference",
+        "points"
+      ],
+      "properties": {
+        "adjustment": {
+          "description": "Total of the point adjustments",
+          "type": "integer"
+        },
+        "awardedGoalsAgainst": {
+          "description": "Part of goalsAgainst coming from awarded results",
+          "type": "integer"
+        },
+        "awardedGoalsFor": {
+          "description": "Part of goalsFor coming from awarded results",
+          "type": "integer"
+        },
+        "basePoints": {
+          "description": "Points earned in games",
+          "type": "integer"
+        },
+        "drawn": {
+          "type": "integer"
+        },
+        "goalDifference": {
+          "type": "integer"
+        },
+        "goalsAgainst": {
+          "type": "integer"
+        },
+        "goalsFor": {
+          "type": "integer"
+        },
+        "lost": {
+          "type": "integer"
+        },
+        "overtimeLost": {
+          "description": "Losses in extra time or by penalties",
+          "type": "integer"
+        },
+        "overtimeWon": {
+          "description": "Wins in extra time or by penalties",
+          "type": "integer"
+        },
+        "played": {
+          "type": "integer"
+        },
+        "points": {
+          "description": "Final points, basePoints plus adjustment",
+          "type": "integer"
+        },
+        "regulationWon": {
+          "description": "Wins in regulation time",
+          "type": "integer"
+        },
+        "team": {
+          "type": "string",
+          "minLength": 1
+        },
+        "won": {
+          "type": "integer"
+        },
+        "zone": {
+          "description": "Zone of the team in the ranked standings",
+          "type": "string",
+          "enum": [
+            "promotion",
+            "promotion-playoff",
+            "relegation-playoff",
+            "relegation"
+          ]
+        }
+      }
+    },
+    "swissRound": {
+      "type": "object",
+      "properties": {
+        "teams": {
+          "description": "Teams from the top seed, needed for the first round only",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        }
+      }
+    },
+    "team": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "aliases": {
+          "description": "Other names of the team, replaced by its name in games",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "name": {
+          "type": "string",
+          "minLength": 1
+        },
+        "shortCode": {
+          "description": "Abbreviation like ARS",
+          "type": "string"
+        }
+      }
+    },
+    "teamChange": {
+      "type": "object",
+      "required": [
+        "id",
+        "teamId",
+        "action",
+        "from",
+        "to",
+        "principal",
+        "at"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "rename",
+            "merge"
+          ]
+        },
+        "at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "from": {
+          "description": "Name before the rename, or name of the merged team",
+          "type": "string"
+        },
+        "id": {
+          "type": "integer"
+        },
+        "principal": {
+          "type": "string"
+        },
+        "requestId": {
+          "type": "string"
+        },
+        "teamId": {
+          "type": "integer"
+        },
+        "to": {
+          "type": "string"
+        }
+      }
+    },
+    "teamMerge": {
+      "type": "object",
+      "required": [
+        "from"
+      ],
+      "properties": {
+        "from": {
+          "description": "Team created by mistake, removed once merged",
+          "type": "integer"
+        }
+      }
+    },
+    "teamRename": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "name": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "webhook": {
+      "type": "object",
+      "required": [
+        "url",
+        "events"
+      ],
+      "properties": {
+        "active": {
+          "type": "boolean",
+          "default": true
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "events": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string",
+            "enum": [
+              "game.created",
+              "game.updated",
+              "game.deleted",
+              "fixture.updated",
+              "points.adjusted",
+              "standings.leader_changed"
+            ]
+          }
+        },
+        "id": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "secret": {
+          "description": "Key of the HMAC-SHA256 signature of the payloads, sent in the X-Webhook-Signature header. Generated when not set, and returned on creation only. Kept when not set on update.\n",
+          "type": "string"
+        },
+        "url": {
+          "description": "Absolute http or https URL the events are posted to",
+          "type": "string"
+        }
+      }
+    },
+    "webhookDelivery": {
+      "type": "object",
+      "required": [
+        "id",
+        "webhookId",
+        "eventId",
+        "event",
+        "status",
+        "attempts",
+        "createdAt"
+      ],
+      "properties": {
+        "attempts": {
+          "type": "integer"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "event": {
+          "type": "string"
+        },
+        "eventId": {
+          "type": "integer"
+        },
+        "id": {
+          "type": "integer"
+        },
+        "log": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/deliveryAttempt"
+          }
+        },
+        "nextAttemptAt": {
+          "description": "Set for the pending deliveries only",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "delivered",
+            "dead"
+          ]
+        },
+        "webhookId": {
+          "type": "integer"
+        }
+      }
+    },
+    "zones": {
+      "description": "Numbers of places, counted from the top or from the bottom of the standings, by which teams leave the division",
+      "type": "object",
+      "properties": {
+        "promotion": {
+          "type": "integer"
+        },
+        "promotionPlayoff": {
+          "type": "integer"
+        },
+        "relegation": {
+          "type": "integer"
+        },
+        "relegationPlayoff": {
+          "type": "integer"
+        }
+      }
+    }
+  },
+  "securityDefinitions": {
+    "key": {
+      "type": "apiKey",
+      "name": "x-token",
+      "in": "header"
+    }
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/slawekzachcial.tournament.v1+json"
+  ],
+  "produces": [
+    "application/slawekzachcial.tournament.v1+json"
+  ],
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "title": "Tournament",
+    "version": "1.0.0"
+  },
+  "paths": {
+    "/audit": {
+      "get": {
+        "operationId": "getAuditTrail",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Competition of the games, the default competition when not set",
+            "name": "competition",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "principal",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Lists the changes older than the change with this ID",
+            "name": "before",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Changes made to games, the latest first",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/auditEntry"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/brackets": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "createBracket",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/newBracket"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created bracket",
+            "schema": {
+              "$ref": "#/definitions/bracket"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/brackets/{id}": {
+      "get": {
+        "operationId": "getBracket",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get bracket",
+            "schema": {
+              "$ref": "#/definitions/bracket"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/competitions": {
+      "get": {
+        "operationId": "listCompetitions",
+        "responses": {
+          "200": {
+            "description": "List all competitions",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/competition"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "createCompetition",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/newCompetition"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created competition",
+            "schema": {
+              "$ref": "#/definitions/competition"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/competitions/rollover": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "rolloverSeason",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/rollover"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Competitions of the new season, from the top division",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/competition"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/competitions/{id}": {
+      "get": {
+        "operationId": "getCompetition",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get competition",
+            "schema": {
+              "$ref": "#/definitions/competition"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/competitions/{id}/close": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "closeSeason",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Final standings of the season",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/stats"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/competitions/{id}/fixtures": {
+      "get": {
+        "operationId": "getCompetitionFixtures",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "scheduled",
+              "inProgress",
+              "completed",
+              "postponed",
+              "cancelled",
+              "abandoned"
+            ],
+            "type": "string",
+            "description": "Lists only the fixtures with the status",
+            "name": "status",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "List all fixtures of the competition",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/fixture"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/competitions/{id}/games": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "playInCompetition",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/game"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/game"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/competitions/{id}/movements": {
+      "get": {
+        "description": "Teams in the promotion and relegation zones, based on the final standings once the season is closed",
+        "operationId": "getCompetitionMovements",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Teams leaving the division by zone",
+            "schema": {
+              "$ref": "#/definitions/movements"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/competitions/{id}/stats": {
+      "get": {
+        "operationId": "getCompetitionStats",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "List all teams statistics of the competition",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/stats"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/competitions/{id}/stats/{team}": {
+      "get": {
+        "operationId": "getCompetitionTeamStats",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "team",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get team stats in the competition",
+            "schema": {
+              "$ref": "#/definitions/stats"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/fixtures": {
+      "get": {
+        "operationId": "getFixtures",
+        "parameters": [
+          {
+            "enum": [
+              "scheduled",
+              "inProgress",
+              "completed",
+              "postponed",
+              "cancelled",
+              "abandoned"
+            ],
+            "type": "string",
+            "description": "Lists only the fixtures with the status",
+            "name": "status",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "List all fixtures",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/fixture"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "scheduleFixtures",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/schedule"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created fixtures",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/fixture"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/fixtures/swiss": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "description": "Pairs the next round of a Swiss-system tournament once all games of the previous round are played",
+        "operationId": "pairSwissRound",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/swissRound"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created fixtures of the round",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/fixture"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/fixtures/{id}": {
+      "patch": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "updateFixtureStatus",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/fixturePatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated fixture",
+            "schema": {
+              "$ref": "#/definitions/fixture"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/fixtures/{id}/result": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "description": "Records the game of the fixture and completes it, or corrects the game of a completed fixture",
+        "operationId": "recordFixtureResult",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/fixtureResult"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Recorded game",
+            "schema": {
+              "$ref": "#/definitions/game"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/games": {
+      "get": {
+        "operationId": "listGames",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Competition of the games, the default competition when not set",
+            "name": "competition",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "team",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Opponent of the team",
+            "name": "opponent",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "win",
+              "draw",
+              "loss"
+            ],
+            "type": "string",
+            "description": "Result of the games for the team",
+            "name": "result",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Games played at or after this time",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Games played before this time",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "playedAt",
+              "recordedAt"
+            ],
+            "type": "string",
+            "default": "playedAt",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "asc",
+              "desc"
+            ],
+            "type": "string",
+            "default": "asc",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "nextCursor of the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "maximum": 500,
+            "minimum": 1,
+            "type": "integer",
+            "default": 50,
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Page of games",
+            "schema": {
+              "$ref": "#/definitions/gamePage"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "play",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/game"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/game"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/games/{id}": {
+      "get": {
+        "operationId": "getGame",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get game",
+            "schema": {
+              "$ref": "#/definitions/game"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "updateGame",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/game"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated game",
+            "schema": {
+              "$ref": "#/definitions/game"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "deleteGame",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "patchGame",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/gamePatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated game",
+            "schema": {
+              "$ref": "#/definitions/game"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/games/{id}/history": {
+      "get": {
+        "operationId": "getGameHistory",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Changes made to the game, the oldest first",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/auditEntry"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/group-stages": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "createGroupStage",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/newGroupStage"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created group stage",
+            "schema": {
+              "$ref": "#/definitions/groupStage"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/group-stages/{id}": {
+      "get": {
+        "operationId": "getGroupStage",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get group stage with group standings",
+            "schema": {
+              "$ref": "#/definitions/groupStage"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/live": {
+      "get": {
+        "description": "WebSocket feed of the games being played. Subscribers receive liveMessage updates, and scorekeepers authenticated with x-token send liveCommand messages.",
+        "operationId": "getLiveFeed",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "API key of scorekeepers",
+            "name": "x-token",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "101": {
+            "description": "Switching to the WebSocket live feed"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/stats": {
+      "get": {
+        "operationId": "getAllStats",
+        "responses": {
+          "200": {
+            "description": "List all teams statistics",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/stats"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/stats/head-to-head": {
+      "get": {
+        "operationId": "getHeadToHeadStats",
+        "parameters": [
+          {
+            "minItems": 2,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "csv",
+            "name": "teams",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "List teams statistics from the games played between them",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/stats"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/stats/stream": {
+      "get": {
+        "produces": [
+          "text/event-stream"
+        ],
+        "operationId": "getStatsStream",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ID of the last event received before reconnecting",
+            "name": "Last-Event-ID",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Server-Sent Events with all teams statistics, sent on connection and whenever they change",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/stats/{team}": {
+      "get": {
+        "operationId": "getTeamStats",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "team",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get team stats",
+            "schema": {
+              "$ref": "#/definitions/stats"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/stats/{team}/adjustments": {
+      "get": {
+        "operationId": "getTeamAdjustments",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "team",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Adjustments of the team points, the oldest first",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/pointAdjustment"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "adjustPoints",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "team",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/pointAdjustment"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Adjustment added to the team points",
+            "schema": {
+              "$ref": "#/definitions/pointAdjustment"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/teams": {
+      "get": {
+        "operationId": "listTeams",
+        "responses": {
+          "200": {
+            "description": "List all teams",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/team"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "createTeam",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/team"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created team",
+            "schema": {
+              "$ref": "#/definitions/team"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/teams/{id}": {
+      "get": {
+        "operationId": "getTeam",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get team",
+            "schema": {
+              "$ref": "#/definitions/team"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "updateTeam",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/team"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated team",
+            "schema": {
+              "$ref": "#/definitions/team"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "deleteTeam",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/teams/{id}/history": {
+      "get": {
+        "operationId": "getTeamHistory",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Renames of the team and merges into it, the oldest first",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/teamChange"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/teams/{id}/merge": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "mergeTeams",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/teamMerge"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Team with the games and names of the merged team",
+            "schema": {
+              "$ref": "#/definitions/team"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/teams/{id}/rename": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "renameTeam",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/teamRename"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Renamed team, keeping the old name as alias",
+            "schema": {
+              "$ref": "#/definitions/team"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks": {
+      "get": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "listWebhooks",
+        "responses": {
+          "200": {
+            "description": "List all webhooks, without their secrets",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/webhook"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "createWebhook",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created webhook, with its secret",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/deliveries/{id}/retry": {
+      "post": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "retryWebhookDelivery",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Dead delivery made pending again",
+            "schema": {
+              "$ref": "#/definitions/webhookDelivery"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/{id}": {
+      "get": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "getWebhook",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Get webhook, without its secret",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "updateWebhook",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated webhook, without its secret",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "deleteWebhook",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Deleted, along with its deliveries"
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/{id}/deliveries": {
+      "get": {
+        "security": [
+          {
+            "key": []
+          }
+        ],
+        "operationId": "getWebhookDeliveries",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "pending",
+              "delivered",
+              "dead"
+            ],
+            "type": "string",
+            "description": "Lists the dead deliveries only when dead",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Deliveries of events to the webhook with their attempts, the latest first",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/webhookDelivery"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "auditEntry": {
+      "type": "object",
+      "required": [
+        "id",
+        "gameId",
+        "action",
+        "principal",
+        "requestId",
+        "at"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "create",
+            "update",
+            "delete"
+          ]
+        },
+        "after": {
+          "$ref": "#/definitions/game"
+        },
+        "at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "before": {
+          "$ref": "#/definitions/game"
+        },
+        "gameId": {
+          "type": "integer"
+        },
+        "id": {
+          "type": "integer"
+        },
+        "principal": {
+          "type": "string"
+        },
+        "requestId": {
+          "type": "string"
+        }
+      }
+    },
+    "bracket": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "final"
+      ],
+      "properties": {
+        "final": {
+          "$ref": "#/definitions/bracketMatch"
+        },
+        "id": {
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "bracketMatch": {
+      "type": "object",
+      "required": [
+        "round",
+        "position"
+      ],
+      "properties": {
+        "position": {
+          "type": "integer"
+        },
+        "previous": {
+          "description": "Matches whose winners play this match",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bracketMatch"
+          }
+        },
+        "round": {
+          "type": "integer"
+        },
+        "teamA": {
+          "type": "string"
+        },
+        "teamB": {
+          "type": "string"
+        },
+        "winner": {
+          "type": "string"
+        }
+      }
+    },
+    "competition": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "season",
+        "format",
+        "scoring"
+      ],
+      "properties": {
+        "closed": {
+          "description": "Closed seasons no longer accept games and keep their final standings",
+          "type": "boolean"
+        },
+        "format": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
+        },
+        "scoring": {
+          "$ref": "#/definitions/scoringRules"
+        },
+        "season": {
+          "type": "string"
+        },
+        "teams": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "zones": {
+          "$ref": "#/definitions/zones"
+        }
+      }
+    },
+    "deliveryAttempt": {
+      "type": "object",
+      "required": [
+        "attempt",
+        "durationMs",
+        "at"
+      ],
+      "properties": {
+        "at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "attempt": {
+          "type": "integer"
+        },
+        "durationMs": {
+          "type": "integer"
+        },
+        "error": {
+          "type": "string"
+        },
+        "statusCode": {
+          "description": "Not set when no response was received",
+          "type": "integer"
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "fields": {
+          "description": "Errors of the invalid fields",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/fieldError"
+          }
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "fieldError": {
+      "type": "object",
+      "required": [
+        "field",
+        "message"
+      ],
+      "properties": {
+        "field": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "fixture": {
+      "type": "object",
+      "required": [
+        "round",
+        "homeTeam"
+      ],
+      "properties": {
+        "awayTeam": {
+          "description": "Not set when homeTeam has a bye",
+          "type": "string"
+        },
+        "gameId": {
+          "description": "Game recorded as the result, counted in the stats while the fixture is completed",
+          "type": "integer",
+          "readOnly": true
+        },
+        "homeTeam": {
+          "type": "string",
+          "minLength": 1
+        },
+        "id": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "round": {
+          "type": "integer"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "scheduled",
+            "inProgress",
+            "completed",
+            "postponed",
+            "cancelled",
+            "abandoned"
+          ],
+          "readOnly": true
+        }
+      }
+    },
+    "fixturePatch": {
+      "type": "object",
+      "required": [
+        "status"
+      ],
+      "properties": {
+        "status": {
+          "type": "string",
+          "enum": [
+            "scheduled",
+            "inProgress",
+            "postponed",
+            "cancelled",
+            "abandoned"
+          ]
+        }
+      }
+    },
+    "fixtureResult": {
+      "type": "object",
+      "required": [
+        "homeScore",
+        "awayScore"
+      ],
+      "properties": {
+        "awayPenalties": {
+          "type": "integer",
+          "minimum": 0
+        },
+        "awayScore": {
+          "type": "integer",
+          "minimum": 0
+        },
+        "decidedIn": {
+          "type": "string",
+          "default": "regulation",
+          "enum": [
+            "regulation",
+            "extraTime",
+            "penalties"
+          ]
+        },
+        "homePenalties": {
+          "type": "integer",
+          "minimum": 0
+        },
+        "homeScore": {
+          "type": "integer",
+          "minimum": 0
+        },
+        "outcome": {
+          "type": "string",
+          "default": "played",
+          "enum": [
+            "played",
+            "forfeit",
+            "walkover",
+            "doubleForfeit"
+          ]
+        },
+        "playedAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
     },
     "game": {
       "type": "object",
       "required": [
-        "teamA",
-        "scoreA",
-        "teamB",
-        "scoreB"
+        "teamA",
+        "scoreA",
+        "teamB",
+        "scoreB"
+      ],
+      "properties": {
+        "decidedIn": {
+          "description": "Period in which a game that needed a winner was settled",
+          "type": "string",
+          "default": "regulation",
+          "enum": [
+            "regulation",
+            "extraTime",
+            "penalties"
+          ]
+        },
+        "id": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "outcome": {
+          "description": "Whether the game was played or its result awarded, the awarded scores naming the winner of a forfeit or walkover",
+          "type": "string",
+          "default": "played",
+          "enum": [
+            "played",
+            "forfeit",
+            "walkover",
+            "doubleForfeit"
+          ]
+        },
+        "penaltiesA": {
+          "type": "integer",
+          "minimum": 0
+        },
+        "penaltiesB": {
+          "type": "integer",
+          "minimum": 0
+        },
+        "playedAt": {
+          "description": "When the game was played, the time it is recorded when not set",
+          "type": "string",
+          "format": "date-time"
+        },
+        "recordedAt": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "scoreA": {
+          "type": "integer"
+        },
+        "scoreB": {
+          "type": "integer"
+        },
+        "teamA": {
+          "type": "string",
+          "minLength": 1
+        },
+        "teamB": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "gamePage": {
+      "type": "object",
+      "required": [
+        "games"
+      ],
+      "properties": {
+        "games": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/game"
+          }
+        },
+        "nextCursor": {
+          "description": "Cursor of the next page, not set on the last page",
+          "type": "string"
+        }
+      }
+    },
+    "gamePatch": {
+      "description": "Fields of the game to change",
+      "type": "object",
+      "properties": {
+        "decidedIn": {
+          "type": "string",
+          "enum": [
+            "regulation",
+            "extraTime",
+            "penalties"
+          ],
+          "x-nullable": true
+        },
+        "outcome": {
+          "type": "string",
+          "enum": [
+            "played",
+            "forfeit",
+            "walkover",
+            "doubleForfeit"
+          ],
+          "x-nullable": true
+        },
+        "penaltiesA": {
+          "type": "integer",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "penaltiesB": {
+          "type": "integer",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "playedAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "scoreA": {
+          "type": "integer",
+          "x-nullable": true
+        },
+        "scoreB": {
+          "type": "integer",
+          "x-nullable": true
+        },
+        "teamA": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": true
+        },
+        "teamB": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": true
+        }
+      }
+    },
+    "group": {
+      "type": "object",
+      "required": [
+        "name",
+        "teams"
+      ],
+      "properties": {
+        "name": {
+          "type": "string",
+          "minLength": 1
+        },
+        "teams": {
+          "type": "array",
+          "minItems": 2,
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        }
+      }
+    },
+    "groupStage": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "qualifiers",
+        "groups"
+      ],
+      "properties": {
+        "bracketId": {
+          "description": "Knockout bracket, set once all group games are played",
+          "type": "integer"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/groupTable"
+          }
+        },
+        "id": {
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
+        },
+        "qualifiers": {
+          "type": "integer"
+        }
+      }
+    },
+    "groupTable": {
+      "type": "object",
+      "required": [
+        "name",
+        "standings"
+      ],
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "standings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/stats"
+          }
+        }
+      }
+    },
+    "liveCommand": {
+      "type": "object",
+      "required": [
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "start",
+            "score",
+            "end",
+            "cancel"
+          ]
+        },
+        "game": {
+          "$ref": "#/definitions/game"
+        },
+        "id": {
+          "description": "ID of the live game, not set to start a game",
+          "type": "integer"
+        }
+      }
+    },
+    "liveGame": {
+      "type": "object",
+      "required": [
+        "id",
+        "game"
+      ],
+      "properties": {
+        "game": {
+          "$ref": "#/definitions/game"
+        },
+        "id": {
+          "description": "ID of the live game, the recorded game gets its own ID when the game ends",
+          "type": "integer"
+        },
+        "startedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "liveMessage": {
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "error": {
+          "$ref": "#/definitions/error"
+        },
+        "liveGame": {
+          "$ref": "#/definitions/liveGame"
+        },
+        "liveGames": {
+          "description": "Games being played, in a snapshot sent on connection",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/liveGame"
+          }
+        },
+        "result": {
+          "$ref": "#/definitions/game"
+        },
+        "standings": {
+          "description": "Provisional standings including the games being played",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/stats"
+          }
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "snapshot",
+            "started",
+            "updated",
+            "ended",
+            "cancelled",
+            "standings",
+            "error"
+          ]
+        }
+      }
+    },
+    "movements": {
+      "type": "object",
+      "properties": {
+        "promoted": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "promotionPlayoff": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "relegated": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "relegationPlayoff": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "newBracket": {
+      "type": "object",
+      "required": [
+        "name",
+        "teams"
       ],
       "properties": {
-        "scoreA": {
-          "type": "integer"
+        "name": {
+          "type": "string",
+          "minLength": 1
         },
-        "scoreB": {
-          "type": "integer"
+        "teams": {
+          "description": "Teams from the top seed",
+          "type": "array",
+          "minItems": 2,
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        }
+      }
+    },
+    "newCompetition": {
+      "type": "object",
+      "required": [
+        "name",
+        "format"
+      ],
+      "properties": {
+        "format": {
+          "type": "string",
+          "enum": [
+            "league",
+            "knockout",
+            "swiss",
+            "groups"
+          ]
         },
-        "teamA": {
+        "name": {
           "type": "string",
           "minLength": 1
         },
-        "teamB": {
+        "scoring": {
+          "$ref": "#/definitions/scoringRules"
+        },
+        "season": {
+          "type": "string"
+        },
+        "teams": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        },
+        "zones": {
+          "$ref": "#/definitions/zones"
+        }
+      }
+    },
+    "newGroupStage": {
+      "type": "object",
+      "required": [
+        "name",
+        "groups",
+        "qualifiers"
+      ],
+      "properties": {
+        "groups": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/group"
+          }
+        },
+        "name": {
+          "type": "string",
+          "minLength": 1
+        },
+        "qualifiers": {
+          "description": "Number of best placed teams of every group qualifying for the knockout bracket",
+          "type": "integer",
+          "minimum": 1
+        }
+      }
+    },
+    "pointAdjustment": {
+      "type": "object",
+      "required": [
+        "delta",
+        "reason"
+      ],
+      "properties": {
+        "at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "delta": {
+          "description": "Points added to the team, or deducted when negative",
+          "type": "integer"
+        },
+        "id": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "principal": {
+          "type": "string",
+          "readOnly": true
+        },
+        "reason": {
           "type": "string",
+          "maxLength": 200,
           "minLength": 1
+        },
+        "requestId": {
+          "type": "string",
+          "readOnly": true
+        },
+        "team": {
+          "type": "string",
+          "readOnly": true
         }
       }
     },
     "principal": {
       "type": "string"
     },
+    "rollover": {
+      "type": "object",
+      "required": [
+        "season",
+        "divisions"
+      ],
+      "properties": {
+        "divisions": {
+          "description": "IDs of the closed competitions, from the top division",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "integer"
+          }
+        },
+        "playoffMovers": {
+          "description": "Teams from playoff places which won promotion or lost relegation in the playoffs",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "promotions": {
+          "description": "Number of teams promoted from every division without zones and relegated from the one above",
+          "type": "integer",
+          "minimum": 0
+        },
+        "season": {
+          "type": "string"
+        }
+      }
+    },
+    "schedule": {
+      "type": "object",
+      "required": [
+        "teams"
+      ],
+      "properties": {
+        "double": {
+          "description": "Play every pairing twice, home and away",
+          "type": "boolean"
+        },
+        "teams": {
+          "type": "array",
+          "minItems": 2,
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        }
+      }
+    },
+    "scoringRules": {
+      "type": "object",
+      "required": [
+        "win",
+        "draw",
+        "loss"
+      ],
+      "properties": {
+        "bigWinBonus": {
+          "type": "integer"
+        },
+        "bigWinMargin": {
+          "description": "Goal margin from which a win earns bigWinBonus points, 0 disables the bonus",
+          "type": "integer",
+          "minimum": 0
+        },
+        "draw": {
+          "type": "integer"
+        },
+        "forfeitDeduction": {
+          "description": "Points taken from a team losing by forfeit",
+          "type": "integer",
+          "minimum": 0
+        },
+        "loss": {
+          "type": "integer"
+        },
+        "overtime": {
+          "description": "Scores the games decided in extra time or by penalties with overtimeWin and overtimeLoss points, otherwise a game decided by penalties is a draw",
+          "type": "boolean"
+        },
+        "overtimeLoss": {
+          "type": "integer"
+        },
+        "overtimeWin": {
+          "type": "integer"
+        },
+        "win": {
+          "type": "integer"
+        }
+      }
+    },
     "stats": {
       "type": "object",
       "required": [
@@ -346,19 +5447,60 @@ func init() {
         "won",
         "drawn",
         "lost",
+        "goalsFor",
+        "goalsAgainst",
+        "goalDifference",
         "points"
       ],
       "properties": {
+        "adjustment": {
+          "description": "Total of the point adjustments",
+          "type": "integer"
+        },
+        "awardedGoalsAgainst": {
+          "description": "Part of goalsAgainst coming from awarded results",
+          "type": "integer"
+        },
+        "awardedGoalsFor": {
+          "description": "Part of goalsFor coming from awarded results",
+          "type": "integer"
+        },
+        "basePoints": {
+          "description": "Points earned in games",
+          "type": "integer"
+        },
         "drawn": {
           "type": "integer"
         },
+        "goalDifference": {
+          "type": "integer"
+        },
+        "goalsAgainst": {
+          "type": "integer"
+        },
+        "goalsFor": {
+          "type": "integer"
+        },
         "lost": {
           "type": "integer"
         },
+        "overtimeLost": {
+          "description": "Losses in extra time or by penalties",
+          "type": "integer"
+        },
+        "overtimeWon": {
+          "description": "Wins in extra time or by penalties",
+          "type": "integer"
+        },
         "played": {
           "type": "integer"
         },
         "points": {
+          "description": "Final points, basePoints plus adjustment",
+          "type": "integer"
+        },
+        "regulationWon": {
+          "description": "Wins in regulation time",
           "type": "integer"
         },
         "team": {
@@ -367,6 +5509,243 @@ func init() {
         },
         "won": {
           "type": "integer"
+        },
+        "zone": {
+          "description": "Zone of the team in the ranked standings",
+          "type": "string",
+          "enum": [
+            "promotion",
+            "promotion-playoff",
+            "relegation-playoff",
+            "relegation"
+          ]
+        }
+      }
+    },
+    "swissRound": {
+      "type": "object",
+      "properties": {
+        "teams": {
+          "description": "Teams from the top seed, needed for the first round only",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        }
+      }
+    },
+    "team": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "aliases": {
+          "description": "Other names of the team, replaced by its name in games",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "name": {
+          "type": "string",
+          "minLength": 1
+        },
+        "shortCode": {
+          "description": "Abbreviation like ARS",
+          "type": "string"
+        }
+      }
+    },
+    "teamChange": {
+      "type": "object",
+      "required": [
+        "id",
+        "teamId",
+        "action",
+        "from",
+        "to",
+        "principal",
+        "at"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "rename",
+            "merge"
+          ]
+        },
+        "at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "from": {
+          "description": "Name before the rename, or name of the merged team",
+          "type": "string"
+        },
+        "id": {
+          "type": "integer"
+        },
+        "principal": {
+          "type": "string"
+        },
+        "requestId": {
+          "type": "string"
+        },
+        "teamId": {
+          "type": "integer"
+        },
+        "to": {
+          "type": "string"
+        }
+      }
+    },
+    "teamMerge": {
+      "type": "object",
+      "required": [
+        "from"
+      ],
+      "properties": {
+        "from": {
+          "description": "Team created by mistake, removed once merged",
+          "type": "integer"
+        }
+      }
+    },
+    "teamRename": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "name": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "webhook": {
+      "type": "object",
+      "required": [
+        "url",
+        "events"
+      ],
+      "properties": {
+        "active": {
+          "type": "boolean",
+          "default": true
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "events": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string",
+            "enum": [
+              "game.created",
+              "game.updated",
+              "game.deleted",
+              "fixture.updated",
+              "points.adjusted",
+              "standings.leader_changed"
+            ]
+          }
+        },
+        "id": {
+          "type": "integer",
+          "readOnly": true
+        },
+        "secret": {
+          "description": "Key of the HMAC-SHA256 signature of the payloads, sent in the X-Webhook-Signature header. Generated when not set, and returned on creation only. Kept when not set on update.\n",
+          "type": "string"
+        },
+        "url": {
+          "description": "Absolute http or https URL the events are posted to",
+          "type": "string"
+        }
+      }
+    },
+    "webhookDelivery": {
+      "type": "object",
+      "required": [
+        "id",
+        "webhookId",
+        "eventId",
+        "event",
+        "status",
+        "attempts",
+        "createdAt"
+      ],
+      "properties": {
+        "attempts": {
+          "type": "integer"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "event": {
+          "type": "string"
+        },
+        "eventId": {
+          "type": "integer"
+        },
+        "id": {
+          "type": "integer"
+        },
+        "log": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/deliveryAttempt"
+          }
+        },
+        "nextAttemptAt": {
+          "description": "Set for the pending deliveries only",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "delivered",
+            "dead"
+          ]
+        },
+        "webhookId": {
+          "type": "integer"
+        }
+      }
+    },
+    "zones": {
+      "description": "Numbers of places, counted from the top or from the bottom of the standings, by which teams leave the division",
+      "type": "object",
+      "properties": {
+        "promotion": {
+          "type": "integer",
+          "minimum": 0
+        },
+        "promotionPlayoff": {
+          "type": "integer",
+          "minimum": 0
+        },
+        "relegation": {
+          "type": "integer",
+          "minimum": 0
+        },
+        "relegationPlayoff": {
+          "type": "integer",
+          "minimum": 0
         }
       }
     }
@@ -21,6 +21,11 @@ const PlayCreatedCode int = 201
 swagger:response playCreated
 */
 type PlayCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Game `json:"body,omitempty"`
 }
 
 // NewPlayCreated creates PlayCreated with default headers values
@@ -29,12 +34,27 @@ func NewPlayCreated() *PlayCreated {
 	return &PlayCreated{}
 }
 
+// WithPayload adds the payload to the play created response
+func (o *PlayCreated) WithPayload(payload *models.Game) *PlayCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the play created response
+func (o *PlayCreated) SetPayload(payload *models.Game) {
+	o.Payload = payload
+}
+
 // WriteResponse to the client
 func (o *PlayCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
-	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
-
 	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
 }
 
 /*PlayDefault Error
@@ -7,6 +7,7 @@ package operations
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -43,16 +44,157 @@ func NewTournamentAPI(spec *loads.Document) *TournamentAPI {
 		JSONConsumer: runtime.JSONConsumer(),
 
 		JSONProducer: runtime.JSONProducer(),
+		TextEventStreamProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("textEventStream producer has not yet been implemented")
+		}),
 
+		AdjustPointsHandler: AdjustPointsHandlerFunc(func(params AdjustPointsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation AdjustPoints has not yet been implemented")
+		}),
+		CloseSeasonHandler: CloseSeasonHandlerFunc(func(params CloseSeasonParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation CloseSeason has not yet been implemented")
+		}),
+		CreateBracketHandler: CreateBracketHandlerFunc(func(params CreateBracketParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation CreateBracket has not yet been implemented")
+		}),
+		CreateCompetitionHandler: CreateCompetitionHandlerFunc(func(params CreateCompetitionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation CreateCompetition has not yet been implemented")
+		}),
+		CreateGroupStageHandler: CreateGroupStageHandlerFunc(func(params CreateGroupStageParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation CreateGroupStage has not yet been implemented")
+		}),
+		CreateTeamHandler: CreateTeamHandlerFunc(func(params CreateTeamParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation CreateTeam has not yet been implemented")
+		}),
+		CreateWebhookHandler: CreateWebhookHandlerFunc(func(params CreateWebhookParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation CreateWebhook has not yet been implemented")
+		}),
+		DeleteGameHandler: DeleteGameHandlerFunc(func(params DeleteGameParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteGame has not yet been implemented")
+		}),
+		DeleteTeamHandler: DeleteTeamHandlerFunc(func(params DeleteTeamParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteTeam has not yet been implemented")
+		}),
+		DeleteWebhookHandler: DeleteWebhookHandlerFunc(func(params DeleteWebhookParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteWebhook has not yet been implemented")
+		}),
 		GetAllStatsHandler: GetAllStatsHandlerFunc(func(params GetAllStatsParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetAllStats has not yet been implemented")
 		}),
+		GetAuditTrailHandler: GetAuditTrailHandlerFunc(func(params GetAuditTrailParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetAuditTrail has not yet been implemented")
+		}),
+		GetBracketHandler: GetBracketHandlerFunc(func(params GetBracketParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetBracket has not yet been implemented")
+		}),
+		GetCompetitionHandler: GetCompetitionHandlerFunc(func(params GetCompetitionParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetCompetition has not yet been implemented")
+		}),
+		GetCompetitionFixturesHandler: GetCompetitionFixturesHandlerFunc(func(params GetCompetitionFixturesParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetCompetitionFixtures has not yet been implemented")
+		}),
+		GetCompetitionMovementsHandler: GetCompetitionMovementsHandlerFunc(func(params GetCompetitionMovementsParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetCompetitionMovements has not yet been implemented")
+		}),
+		GetCompetitionStatsHandler: GetCompetitionStatsHandlerFunc(func(params GetCompetitionStatsParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetCompetitionStats has not yet been implemented")
+		}),
+		GetCompetitionTeamStatsHandler: GetCompetitionTeamStatsHandlerFunc(func(params GetCompetitionTeamStatsParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetCompetitionTeamStats has not yet been implemented")
+		}),
+		GetFixturesHandler: GetFixturesHandlerFunc(func(params GetFixturesParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetFixtures has not yet been implemented")
+		}),
+		GetGameHandler: GetGameHandlerFunc(func(params GetGameParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetGame has not yet been implemented")
+		}),
+		GetGameHistoryHandler: GetGameHistoryHandlerFunc(func(params GetGameHistoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetGameHistory has not yet been implemented")
+		}),
+		GetGroupStageHandler: GetGroupStageHandlerFunc(func(params GetGroupStageParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetGroupStage has not yet been implemented")
+		}),
+		GetHeadToHeadStatsHandler: GetHeadToHeadStatsHandlerFunc(func(params GetHeadToHeadStatsParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetHeadToHeadStats has not yet been implemented")
+		}),
+		GetLiveFeedHandler: GetLiveFeedHandlerFunc(func(params GetLiveFeedParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetLiveFeed has not yet been implemented")
+		}),
+		GetStatsStreamHandler: GetStatsStreamHandlerFunc(func(params GetStatsStreamParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetStatsStream has not yet been implemented")
+		}),
+		GetTeamHandler: GetTeamHandlerFunc(func(params GetTeamParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetTeam has not yet been implemented")
+		}),
+		GetTeamAdjustmentsHandler: GetTeamAdjustmentsHandlerFunc(func(params GetTeamAdjustmentsParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetTeamAdjustments has not yet been implemented")
+		}),
+		GetTeamHistoryHandler: GetTeamHistoryHandlerFunc(func(params GetTeamHistoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetTeamHistory has not yet been implemented")
+		}),
 		GetTeamStatsHandler: GetTeamStatsHandlerFunc(func(params GetTeamStatsParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetTeamStats has not yet been implemented")
 		}),
+		GetWebhookHandler: GetWebhookHandlerFunc(func(params GetWebhookParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation GetWebhook has not yet been implemented")
+		}),
+		GetWebhookDeliveriesHandler: GetWebhookDeliveriesHandlerFunc(func(params GetWebhookDeliveriesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation GetWebhookDeliveries has not yet been implemented")
+		}),
+		ListCompetitionsHandler: ListCompetitionsHandlerFunc(func(params ListCompetitionsParams) middleware.Responder {
+			return middleware.NotImplemented("operation ListCompetitions has not yet been implemented")
+		}),
+		ListGamesHandler: ListGamesHandlerFunc(func(params ListGamesParams) middleware.Responder {
+			return middleware.NotImplemented("operation ListGames has not yet been implemented")
+		}),
+		ListTeamsHandler: ListTeamsHandlerFunc(func(params ListTeamsParams) middleware.Responder {
+			return middleware.NotImplemented("operation ListTeams has not yet been implemented")
+		}),
+		ListWebhooksHandler: ListWebhooksHandlerFunc(func(params ListWebhooksParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation ListWebhooks has not yet been implemented")
+		}),
+		MergeTeamsHandler: MergeTeamsHandlerFunc(func(params MergeTeamsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation MergeTeams has not yet been implemented")
+		}),
+		PairSwissRoundHandler: PairSwissRoundHandlerFunc(func(params PairSwissRoundParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation PairSwissRound has not yet been implemented")
+		}),
+		PatchGameHandler: PatchGameHandlerFunc(func(params PatchGameParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation PatchGame has not yet been implemented")
+		}),
 		PlayHandler: PlayHandlerFunc(func(params PlayParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation Play has not yet been implemented")
 		}),
+		PlayInCompetitionHandler: PlayInCompetitionHandlerFunc(func(params PlayInCompetitionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation PlayInCompetition has not yet been implemented")
+		}),
+		RecordFixtureResultHandler: RecordFixtureResultHandlerFunc(func(params RecordFixtureResultParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation RecordFixtureResult has not yet been implemented")
+		}),
+		RenameTeamHandler: RenameTeamHandlerFunc(func(params RenameTeamParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation RenameTeam has not yet been implemented")
+		}),
+		RetryWebhookDeliveryHandler: RetryWebhookDeliveryHandlerFunc(func(params RetryWebhookDeliveryParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation RetryWebhookDelivery has not yet been implemented")
+		}),
+		RolloverSeasonHandler: RolloverSeasonHandlerFunc(func(params RolloverSeasonParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation RolloverSeason has not yet been implemented")
+		}),
+		ScheduleFixturesHandler: ScheduleFixturesHandlerFunc(func(params ScheduleFixturesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation ScheduleFixtures has not yet been implemented")
+		}),
+		UpdateFixtureStatusHandler: UpdateFixtureStatusHandlerFunc(func(params UpdateFixtureStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation UpdateFixtureStatus has not yet been implemented")
+		}),
+		UpdateGameHandler: UpdateGameHandlerFunc(func(params UpdateGameParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation UpdateGame has not yet been implemented")
+		}),
+		UpdateTeamHandler: UpdateTeamHandlerFunc(func(params UpdateTeamParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation UpdateTeam has not yet been implemented")
+		}),
+		UpdateWebhookHandler: UpdateWebhookHandlerFunc(func(params UpdateWebhookParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation UpdateWebhook has not yet been implemented")
+		}),
 
 		// Applies when the "x-token" header is set
 		KeyAuth: func(token string) (*models.Principal, error) {
@@ -95,6 +237,9 @@ type TournamentAPI struct {
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/slawekzachcial.tournament.v1+json
 	JSONProducer runtime.Producer
+	// TextEventStreamProducer registers a producer for the following mime types:
+	//   - text/event-stream
+	TextEventStreamProducer runtime.Producer
 
 	// KeyAuth registers a function that takes a token and returns a principal
 	// it performs authentication based on an api key x-token provided in the header
@@ -103,12 +248,104 @@ type TournamentAPI struct {
 	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
 	APIAuthorizer runtime.Authorizer
 
+	// AdjustPointsHandler sets the operation handler for the adjust points operation
+	AdjustPointsHandler AdjustPointsHandler
+	// CloseSeasonHandler sets the operation handler for the close season operation
+	CloseSeasonHandler CloseSeasonHandler
+	// CreateBracketHandler sets the operation handler for the create bracket operation
+	CreateBracketHandler CreateBracketHandler
+	// CreateCompetitionHandler sets the operation handler for the create competition operation
+	CreateCompetitionHandler CreateCompetitionHandler
+	// CreateGroupStageHandler sets the operation handler for the create group stage operation
+	CreateGroupStageHandler CreateGroupStageHandler
+	// CreateTeamHandler sets the operation handler for the create team operation
+	CreateTeamHandler CreateTeamHandler
+	// CreateWebhookHandler sets the operation handler for the create webhook operation
+	CreateWebhookHandler CreateWebhookHandler
+	// DeleteGameHandler sets the operation handler for the delete game operation
+	DeleteGameHandler DeleteGameHandler
+	// DeleteTeamHandler sets the operation handler for the delete team operation
+	DeleteTeamHandler DeleteTeamHandler
+	// DeleteWebhookHandler sets the operation handler for the delete webhook operation
+	DeleteWebhookHandler DeleteWebhookHandler
 	// GetAllStatsHandler sets the operation handler for the get all stats operation
 	GetAllStatsHandler GetAllStatsHandler
+	// GetAuditTrailHandler sets the operation handler for the get audit trail operation
+	GetAuditTrailHandler GetAuditTrailHandler
+	// GetBracketHandler sets the operation handler for the get bracket operation
+	GetBracketHandler GetBracketHandler
+	// GetCompetitionHandler sets the operation handler for the get competition operation
+	GetCompetitionHandler GetCompetitionHandler
+	// GetCompetitionFixturesHandler sets the operation handler for the get competition fixtures operation
+	GetCompetitionFixturesHandler GetCompetitionFixturesHandler
+	// GetCompetitionMovementsHandler sets the operation handler for the get competition movements operation
+	GetCompetitionMovementsHandler GetCompetitionMovementsHandler
+	// GetCompetitionStatsHandler sets the operation handler for the get competition stats operation
+	GetCompetitionStatsHandler GetCompetitionStatsHandler
+	// GetCompetitionTeamStatsHandler sets the operation handler for the get competition team stats operation
+	GetCompetitionTeamStatsHandler GetCompetitionTeamStatsHandler
+	// GetFixturesHandler sets the operation handler for the get fixtures operation
+	GetFixturesHandler GetFixturesHandler
+	// GetGameHandler sets the operation handler for the get game operation
+	GetGameHandler GetGameHandler
+	// GetGameHistoryHandler sets the operation handler for the get game history operation
+	GetGameHistoryHandler GetGameHistoryHandler
+	// GetGroupStageHandler sets the operation handler for the get group stage operation
+	GetGroupStageHandler GetGroupStageHandler
+	// GetHeadToHeadStatsHandler sets the operation handler for the get head to head stats operation
+	GetHeadToHeadStatsHandler GetHeadToHeadStatsHandler
+	// GetLiveFeedHandler sets the operation handler for the get live feed operation
+	GetLiveFeedHandler GetLiveFeedHandler
+	// GetStatsStreamHandler sets the operation handler for the get stats stream operation
+	GetStatsStreamHandler GetStatsStreamHandler
+	// GetTeamHandler sets the operation handler for the get team operation
+	GetTeamHandler GetTeamHandler
+	// GetTeamAdjustmentsHandler sets the operation handler for the get team adjustments operation
+	GetTeamAdjustmentsHandler GetTeamAdjustmentsHandler
+	// GetTeamHistoryHandler sets the operation handler for the get team history operation
+	GetTeamHistoryHandler GetTeamHistoryHandler
 	// GetTeamStatsHandler sets the operation handler for the get team stats operation
 	GetTeamStatsHandler GetTeamStatsHandler
+	// GetWebhookHandler sets the operation handler for the get webhook operation
+	GetWebhookHandler GetWebhookHandler
+	// GetWebhookDeliveriesHandler sets the operation handler for the get webhook deliveries operation
+	GetWebhookDeliveriesHandler GetWebhookDeliveriesHandler
+	// ListCompetitionsHandler sets the operation handler for the list competitions operation
+	ListCompetitionsHandler ListCompetitionsHandler
+	// ListGamesHandler sets the operation handler for the list games operation
+	ListGamesHandler ListGamesHandler
+	// ListTeamsHandler sets the operation handler for the list teams operation
+	ListTeamsHandler ListTeamsHandler
+	// ListWebhooksHandler sets the operation handler for the list webhooks operation
+	ListWebhooksHandler ListWebhooksHandler
+	// MergeTeamsHandler sets the operation handler for the merge teams operation
+	MergeTeamsHandler MergeTeamsHandler
+	// PairSwissRoundHandler sets the operation handler for the pair swiss round operation
+	PairSwissRoundHandler PairSwissRoundHandler
+	// PatchGameHandler sets the operation handler for the patch game operation
+	PatchGameHandler PatchGameHandler
 	// PlayHandler sets the operation handler for the play operation
 	PlayHandler PlayHandler
+	// PlayInCompetitionHandler sets the operation handler for the play in competition operation
+	PlayInCompetitionHandler PlayInCompetitionHandler
+	// RecordFixtureResultHandler sets the operation handler for the record fixture result operation
+	RecordFixtureResultHandler RecordFixtureResultHandler
+	// RenameTeamHandler sets the operation handler for the rename team operation
+	RenameTeamHandler RenameTeamHandler
+	// RetryWebhookDeliveryHandler sets the operation handler for the retry webhook delivery operation
+	RetryWebhookDeliveryHandler RetryWebhookDeliveryHandler
+	// RolloverSeasonHandler sets the operation handler for the rollover season operation
+	RolloverSeasonHandler RolloverSeasonHandler
+	// ScheduleFixturesHandler sets the operation handler for the schedule fixtures operation
+	ScheduleFixturesHandler ScheduleFixturesHandler
+	// UpdateFixtureStatusHandler sets the operation handler for the update fixture status operation
+	UpdateFixtureStatusHandler UpdateFixtureStatusHandler
+	// UpdateGameHandler sets the operation handler for the update game operation
+	UpdateGameHandler UpdateGameHandler
+	// UpdateTeamHandler sets the operation handler for the update team operation
+	UpdateTeamHandler UpdateTeamHandler
+	// UpdateWebhookHandler sets the operation handler for the update webhook operation
+	UpdateWebhookHandler UpdateWebhookHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -185,20 +422,161 @@ func (o *TournamentAPI) Validate() error {
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
+	if o.TextEventStreamProducer == nil {
+		unregistered = append(unregistered, "TextEventStreamProducer")
+	}
 
 	if o.KeyAuth == nil {
 		unregistered = append(unregistered, "XTokenAuth")
 	}
 
+	if o.AdjustPointsHandler == nil {
+		unregistered = append(unregistered, "AdjustPointsHandler")
+	}
+	if o.CloseSeasonHandler == nil {
+		unregistered = append(unregistered, "CloseSeasonHandler")
+	}
+	if o.CreateBracketHandler == nil {
+		unregistered = append(unregistered, "CreateBracketHandler")
+	}
+	if o.CreateCompetitionHandler == nil {
+		unregistered = append(unregistered, "CreateCompetitionHandler")
+	}
+	if o.CreateGroupStageHandler == nil {
+		unregistered = append(unregistered, "CreateGroupStageHandler")
+	}
+	if o.CreateTeamHandler == nil {
+		unregistered = append(unregistered, "CreateTeamHandler")
+	}
+	if o.CreateWebhookHandler == nil {
+		unregistered = append(unregistered, "CreateWebhookHandler")
+	}
+	if o.DeleteGameHandler == nil {
+		unregistered = append(unregistered, "DeleteGameHandler")
+	}
+	if o.DeleteTeamHandler == nil {
+		unregistered = append(unregistered, "DeleteTeamHandler")
+	}
+	if o.DeleteWebhookHandler == nil {
+		unregistered = append(unregistered, "DeleteWebhookHandler")
+	}
 	if o.GetAllStatsHandler == nil {
 		unregistered = append(unregistered, "GetAllStatsHandler")
 	}
+	if o.GetAuditTrailHandler == nil {
+		unregistered = append(unregistered, "GetAuditTrailHandler")
+	}
+	if o.GetBracketHandler == nil {
+		unregistered = append(unregistered, "GetBracketHandler")
+	}
+	if o.GetCompetitionHandler == nil {
+		unregistered = append(unregistered, "GetCompetitionHandler")
+	}
+	if o.GetCompetitionFixturesHandler == nil {
+		unregistered = append(unregistered, "GetCompetitionFixturesHandler")
+	}
+	if o.GetCompetitionMovementsHandler == nil {
+		unregistered = append(unregistered, "GetCompetitionMovementsHandler")
+	}
+	if o.GetCompetitionStatsHandler == nil {
+		unregistered = append(unregistered, "GetCompetitionStatsHandler")
+	}
+	if o.GetCompetitionTeamStatsHandler == nil {
+		unregistered = append(unregistered, "GetCompetitionTeamStatsHandler")
+	}
+	if o.GetFixturesHandler == nil {
+		unregistered = append(unregistered, "GetFixturesHandler")
+	}
+	if o.GetGameHandler == nil {
+		unregistered = append(unregistered, "GetGameHandler")
+	}
+	if o.GetGameHistoryHandler == nil {
+		unregistered = append(unregistered, "GetGameHistoryHandler")
+	}
+	if o.GetGroupStageHandler == nil {
+		unregistered = append(unregistered, "GetGroupStageHandler")
+	}
+	if o.GetHeadToHeadStatsHandler == nil {
+		unregistered = append(unregistered, "GetHeadToHeadStatsHandler")
+	}
+	if o.GetLiveFeedHandler == nil {
+		unregistered = append(unregistered, "GetLiveFeedHandler")
+	}
+	if o.GetStatsStreamHandler == nil {
+		unregistered = append(unregistered, "GetStatsStreamHandler")
+	}
+	if o.GetTeamHandler == nil {
+		unregistered = append(unregistered, "GetTeamHandler")
+	}
+	if o.GetTeamAdjustmentsHandler == nil {
+		unregistered = append(unregistered, "GetTeamAdjustmentsHandler")
+	}
+	if o.GetTeamHistoryHandler == nil {
+		unregistered = append(unregistered, "GetTeamHistoryHandler")
+	}
 	if o.GetTeamStatsHandler == nil {
 		unregistered = append(unregistered, "GetTeamStatsHandler")
 	}
+	if o.GetWebhookHandler == nil {
+		unregistered = append(unregistered, "GetWebhookHandler")
+	}
+	if o.GetWebhookDeliveriesHandler == nil {
+		unregistered = append(unregistered, "GetWebhookDeliveriesHandler")
+	}
+	if o.ListCompetitionsHandler == nil {
+		unregistered = append(unregistered, "ListCompetitionsHandler")
+	}
+	if o.ListGamesHandler == nil {
+		unregistered = append(unregistered, "ListGamesHandler")
+	}
+	if o.ListTeamsHandler == nil {
+		unregistered = append(unregistered, "ListTeamsHandler")
+	}
+	if o.ListWebhooksHandler == nil {
+		unregistered = append(unregistered, "ListWebhooksHandler")
+	}
+	if o.MergeTeamsHandler == nil {
+		unregistered = append(unregistered, "MergeTeamsHandler")
+	}
+	if o.PairSwissRoundHandler == nil {
+		unregistered = append(unregistered, "PairSwissRoundHandler")
+	}
+	if o.PatchGameHandler == nil {
+		unregistered = append(unregistered, "PatchGameHandler")
+	}
 	if o.PlayHandler == nil {
 		unregistered = append(unregistered, "PlayHandler")
 	}
+	if o.PlayInCompetitionHandler == nil {
+		unregistered = append(unregistered, "PlayInCompetitionHandler")
+	}
+	if o.RecordFixtureResultHandler == nil {
+		unregistered = append(unregistered, "RecordFixtureResultHandler")
+	}
+	if o.RenameTeamHandler == nil {
+		unregistered = append(unregistered, "RenameTeamHandler")
+	}
+	if o.RetryWebhookDeliveryHandler == nil {
+		unregistered = append(unregistered, "RetryWebhookDeliveryHandler")
+	}
+	if o.RolloverSeasonHandler == nil {
+		unregistered = append(unregistered, "RolloverSeasonHandler")
+	}
+	if o.ScheduleFixturesHandler == nil {
+		unregistered = append(unregistered, "ScheduleFixturesHandler")
+	}
+	if o.UpdateFixtureStatusHandler == nil {
+		unregistered = append(unregistered, "UpdateFixtureStatusHandler")
+	}
+	if o.UpdateGameHandler == nil {
+		unregistered = append(unregistered, "UpdateGameHandler")
+	}
+	if o.UpdateTeamHandler == nil {
+		unregistered = append(unregistered, "UpdateTeamHandler")
+	}
+	if o.UpdateWebhookHandler == nil {
+		unregistered = append(unregistered, "UpdateWebhookHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -258,6 +636,8 @@ func (o *TournamentAPI) ProducersFor(mediaTypes []string) map[string]runtime.Pro
 		switch mt {
 		case "application/slawekzachcial.tournament.v1+json":
 			result["application/slawekzachcial.tournament.v1+json"] = o.JSONProducer
+		case "text/event-stream":
+			result["text/event-stream"] = o.TextEventStreamProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -298,6 +678,46 @@ func (o *TournamentAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/stats/{team}/adjustments"] = NewAdjustPoints(o.context, o.AdjustPointsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/competitions/{id}/close"] = NewCloseSeason(o.context, o.CloseSeasonHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/brackets"] = NewCreateBracket(o.context, o.CreateBracketHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/competitions"] = NewCreateCompetition(o.context, o.CreateCompetitionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/group-stages"] = NewCreateGroupStage(o.context, o.CreateGroupStageHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/teams"] = NewCreateTeam(o.context, o.CreateTeamHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/webhooks"] = NewCreateWebhook(o.context, o.CreateWebhookHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/games/{id}"] = NewDeleteGame(o.context, o.DeleteGameHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/teams/{id}"] = NewDeleteTeam(o.context, o.DeleteTeamHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/webhooks/{id}"] = NewDeleteWebhook(o.context, o.DeleteWebhookHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -305,11 +725,155 @@ func (o *TournamentAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/audit"] = NewGetAuditTrail(o.context, o.GetAuditTrailHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/brackets/{id}"] = NewGetBracket(o.context, o.GetBracketHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/competitions/{id}"] = NewGetCompetition(o.context, o.GetCompetitionHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/competitions/{id}/fixtures"] = NewGetCompetitionFixtures(o.context, o.GetCompetitionFixturesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/competitions/{id}/movements"] = NewGetCompetitionMovements(o.context, o.GetCompetitionMovementsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/competitions/{id}/stats"] = NewGetCompetitionStats(o.context, o.GetCompetitionStatsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/competitions/{id}/stats/{team}"] = NewGetCompetitionTeamStats(o.context, o.GetCompetitionTeamStatsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/fixtures"] = NewGetFixtures(o.context, o.GetFixturesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/games/{id}"] = NewGetGame(o.context, o.GetGameHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/games/{id}/history"] = NewGetGameHistory(o.context, o.GetGameHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/group-stages/{id}"] = NewGetGroupStage(o.context, o.GetGroupStageHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/stats/head-to-head"] = NewGetHeadToHeadStats(o.context, o.GetHeadToHeadStatsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/live"] = NewGetLiveFeed(o.context, o.GetLiveFeedHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/stats/stream"] = NewGetStatsStream(o.context, o.GetStatsStreamHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/teams/{id}"] = NewGetTeam(o.context, o.GetTeamHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/stats/{team}/adjustments"] = NewGetTeamAdjustments(o.context, o.GetTeamAdjustmentsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/teams/{id}/history"] = NewGetTeamHistory(o.context, o.GetTeamHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/stats/{team}"] = NewGetTeamStats(o.context, o.GetTeamStatsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/webhooks/{id}"] = NewGetWebhook(o.context, o.GetWebhookHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/webhooks/{id}/deliveries"] = NewGetWebhookDeliveries(o.context, o.GetWebhookDeliveriesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/competitions"] = NewListCompetitions(o.context, o.ListCompetitionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/games"] = NewListGames(o.context, o.ListGamesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/teams"] = NewListTeams(o.context, o.ListTeamsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/webhooks"] = NewListWebhooks(o.context, o.ListWebhooksHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/teams/{id}/merge"] = NewMergeTeams(o.context, o.MergeTeamsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/fixtures/swiss"] = NewPairSwissRound(o.context, o.PairSwissRoundHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/games/{id}"] = NewPatchGame(o.context, o.PatchGameHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/games"] = NewPlay(o.context, o.PlayHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/competitions/{id}/games"] = NewPlayInCompetition(o.context, o.PlayInCompetitionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/fixtures/{id}/result"] = NewRecordFixtureResult(o.context, o.RecordFixtureResultHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/teams/{id}/rename"] = NewRenameTeam(o.context, o.RenameTeamHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/webhooks/deliveries/{id}/retry"] = NewRetryWebhookDelivery(o.context, o.RetryWebhookDeliveryHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/competitions/rollover"] = NewRolloverSeason(o.context, o.RolloverSeasonHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/fixtures"] = NewScheduleFixtures(o.context, o.ScheduleFixturesHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/fixtures/{id}"] = NewUpdateFixtureStatus(o.context, o.UpdateFixtureStatusHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/games/{id}"] = NewUpdateGame(o.context, o.UpdateGameHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/teams/{id}"] = NewUpdateTeam(o.context, o.UpdateTeamHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/webhooks/{id}"] = NewUpdateWebhook(o.context, o.UpdateWebhookHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -0,0 +1,108 @@
+package tournament
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxAdjustmentReasonLength is the longest reason of a point adjustment.
+const MaxAdjustmentReasonLength = 200
+
+var ErrAdjustmentsNotConfigured = errors.New("Point adjustments repository not configured")
+
+// PointAdjustment adds points to the team, or deducts them when Delta is
+// negative, e.g. for disciplinary reasons.
+type PointAdjustment struct {
+	ID        int
+	Team      string
+	Delta     int
+	Reason    string
+	Principal string
+	RequestID string
+	At        time.Time
+}
+
+type PointAdjustments interface {
+	// Save stores the adjustment setting its ID and time.
+	Save(adjustment *PointAdjustment) error
+	// FindByTeam returns the adjustments of the team, the oldest first.
+	FindByTeam(team string) ([]PointAdjustment, error)
+	FindAll() ([]PointAdjustment, error)
+}
+
+// WithPointAdjustments sets the repository of the adjustments included in
+// the points of the stats.
+func WithPointAdjustments(adjustments PointAdjustments) Option {
+	return func(t *Tournament) {
+		t.adjustments = adjustments
+	}
+}
+
+// AdjustPoints records the adjustment of the team points as made by the
+// actor of the tournament.
+func (t *Tournament) AdjustPoints(adjustment PointAdjustment) (*PointAdjustment, error) {
+	if t.adjustments == nil {
+		return nil, ErrAdjustmentsNotConfigured
+	}
+	if t.archive != nil {
+		return nil, ErrSeasonClosed
+	}
+
+	var err error
+	verr := &ValidationError{}
+	if adjustment.Team, err = t.validateTeam(verr, "team", adjustment.Team); err != nil {
+		return nil, err
+	}
+	if adjustment.Delta == 0 {
+		verr.add("delta", "must not be zero")
+	}
+	adjustment.Reason = strings.TrimSpace(adjustment.Reason)
+	switch {
+	case adjustment.Reason == "":
+		verr.add("reason", "must not be empty")
+	case utf8.RuneCountInString(adjustment.Reason) > MaxAdjustmentReasonLength:
+		verr.add("reason", "must be at most %d characters", MaxAdjustmentReasonLength)
+	}
+	if len(verr.Errors) > 0 {
+		return nil, verr
+	}
+
+	adjustment.Principal = t.actor.Principal
+	adjustment.RequestID = t.actor.RequestID
+	if err := t.adjustments.Save(&adjustment); err != nil {
+		return nil, err
+	}
+	t.publish(PointsAdjusted, Event{Adjustment: &adjustment})
+	return &adjustment, nil
+}
+
+// GetAdjustments returns the adjustments of the team points, the oldest
+// first.
+func (t *Tournament) GetAdjustments(team string) ([]PointAdjustment, error) {
+	if t.adjustments == nil {
+		return nil, ErrAdjustmentsNotConfigured
+	}
+	return t.adjustments.FindByTeam(team)
+}
+
+// adjustStats adds the point adjustments to the stats, including the teams
+// which have adjustments but no games yet.
+func adjustStats(stats []*Stats, adjustments []PointAdjustment) []*Stats {
+	for _, adjustment := range adjustments {
+		var teamStats *Stats
+		for _, s := range stats {
+			if s.Team == adjustment.Team {
+				teamStats = s
+			}
+		}
+		if teamStats == nil {
+			teamStats = &Stats{Team: adjustment.Team}
+			stats = append(stats, teamStats)
+		}
+		teamStats.Adjustment += adjustment.Delta
+		teamStats.Points += adjustment.Delta
+	}
+	return stats
+}
@@ -0,0 +1,101 @@
+package tournament
+
+import (
+	"testing"
+	"time"
+)
+
+type PointAdjustmentsArray struct {
+	adjustments []PointAdjustment
+}
+
+func (pa *PointAdjustmentsArray) Save(adjustment *PointAdjustment) error {
+	adjustment.ID = len(pa.adjustments) + 1
+	adjustment.At = time.Now()
+	pa.adjustments = append(pa.adjustments, *adjustment)
+	return nil
+}
+
+func (pa *PointAdjustmentsArray) FindByTeam(team string) ([]PointAdjustment, error) {
+	adjustments := []PointAdjustment{}
+	for _, adjustment := range pa.adjustments {
+		if adjustment.Team == team {
+			adjustments = append(adjustments, adjustment)
+		}
+	}
+	return adjustments, nil
+}
+
+func (pa *PointAdjustmentsArray) FindAll() ([]PointAdjustment, error) {
+	return pa.adjustments, nil
+}
+
+func TestAdjustPoints(t *testing.T) {
+	tournament := NewTournament(&GamesArray{}, WithPointAdjustments(&PointAdjustmentsArray{}))
+	tournament.Play(Game{TeamA: "a", ScoreA: 2, TeamB: "b", ScoreB: 1})
+	tournament.Play(Game{TeamA: "b", ScoreA: 1, TeamB: "c", ScoreB: 0})
+
+	actor := Actor{Principal: "league", RequestID: "req-1"}
+	adjustment, err := tournament.As(actor).AdjustPoints(PointAdjustment{Team: "A", Delta: -6, Reason: " Fielded an ineligible player "})
+	if err != nil {
+		t.Fatalf("Unexpected error adjusting points: %v", err)
+	}
+	if adjustment.ID == 0 || adjustment.Team != "a" || adjustment.Reason != "Fielded an ineligible player" || adjustment.Principal != "league" || adjustment.RequestID != "req-1" {
+		t.Errorf("Unexpected adjustment: %v", adjustment)
+	}
+	if _, err := tournament.AdjustPoints(PointAdjustment{Team: "d", Delta: 1, Reason: "Fair play award"}); err != nil {
+		t.Fatalf("Unexpected error adjusting points: %v", err)
+	}
+
+	aStats, _ := tournament.GetStats("a")
+	if aStats.Points != -3 || aStats.Adjustment != -6 {
+		t.Errorf("Team 'a' stats - expected -3 points with -6 adjustment, got %v", aStats)
+	}
+
+	dStats, err := tournament.GetStats("d")
+	if err != nil || dStats.Played != 0 || dStats.Points != 1 {
+		t.Errorf("Team 'd' stats - expected 1 point without games, got %v, %v", dStats, err)
+	}
+	if _, err := tournament.GetStats("e"); err != ErrTeamNotFound {
+		t.Errorf("Expected error %v for team without games nor adjustments, got %v", ErrTeamNotFound, err)
+	}
+
+	allStats, _ := tournament.GetAllStats()
+	expectedTeams := []string{"b", "d", "c", "a"}
+	if len(allStats) != len(expectedTeams) {
+		t.Fatalf("All stats - expected %v teams, got %v", len(expectedTeams), allStats)
+	}
+	for i, team := range expectedTeams {
+		if allStats[i].Team != team {
+			t.Errorf("All stats - expected team '%v' at position %v, got %v", team, i+1, allStats[i])
+		}
+	}
+
+	adjustments, _ := tournament.GetAdjustments("a")
+	if len(adjustments) != 1 || adjustments[0].Delta != -6 {
+		t.Errorf("Team 'a' adjustments - expected the -6 adjustment, got %v", adjustments)
+	}
+}
+
+func TestInvalidPointAdjustment(t *testing.T) {
+	tournament := NewTournament(&GamesArray{}, WithPointAdjustments(&PointAdjustmentsArray{}))
+
+	_, err := tournament.AdjustPoints(PointAdjustment{Team: " ", Delta: 0, Reason: ""})
+	verr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("Expected validation error, got %v", err)
+	}
+	fields := map[string]bool{}
+	for _, fe := range verr.Errors {
+		fields[fe.Field] = true
+	}
+	for _, field := range []string{"team", "delta", "reason"} {
+		if !fields[field] {
+			t.Errorf("Expected error of field '%v', got %v", field, verr.Errors)
+		}
+	}
+
+	if _, err := NewTournament(&GamesArray{}).AdjustPoints(PointAdjustment{Team: "a", Delta: 1, Reason: "Bonus"}); err != ErrAdjustmentsNotConfigured {
+		t.Errorf("Expected error %v, got %v", ErrAdjustmentsNotConfigured, err)
+	}
+}
@@ -0,0 +1,172 @@
+package tournament
+
+import (
+	"errors"
+	"time"
+)
+
+// AuditAction is the kind of change made to a game.
+type AuditAction string
+
+const (
+	CreateAction AuditAction = "create"
+	UpdateAction AuditAction = "update"
+	DeleteAction AuditAction = "delete"
+)
+
+const DefaultAuditLimit = 100
+
+var ErrAuditNotConfigured = errors.New("Audit log not configured")
+
+// Actor is who changes the games and in which request.
+type Actor struct {
+	Principal string
+	RequestID string
+}
+
+// AuditEntry records a change of a game. Before is not set for created games
+// and After is not set for deleted ones.
+type AuditEntry struct {
+	ID        int
+	GameID    int
+	Action    AuditAction
+	Principal string
+	RequestID string
+	At        time.Time
+	Before    *Game
+	After     *Game
+}
+
+// AuditFilter selects the audit entries, the latest first. Zero values do not
+// filter.
+type AuditFilter struct {
+	Principal string
+	// BeforeID lists the entries older than the given one
+	BeforeID int
+	Limit    int
+}
+
+// AuditLog is an append-only log of the changes made to games.
+type AuditLog interface {
+	Record(entry *AuditEntry) error
+	FindByGame(gameID int) ([]AuditEntry, error)
+	Find(filter AuditFilter) ([]AuditEntry, error)
+}
+
+// WithAuditLog sets the log recording every change made to games.
+func WithAuditLog(auditLog AuditLog) Option {
+	return func(t *Tournament) {
+		t.auditLog = auditLog
+	}
+}
+
+// As returns the tournament recording the changes it makes to games as made
+// by the actor.
+func (t *Tournament) As(actor Actor) *Tournament {
+	acting := *t
+	acting.actor = actor
+	return &acting
+}
+
+// GameHistory returns the changes made to the game, the oldest first. The
+// history of a deleted game is kept.
+func (t *Tournament) GameHistory(gameID int) ([]AuditEntry, error) {
+	if t.auditLog == nil {
+		return nil, ErrAuditNotConfigured
+	}
+
+	entries, err := t.auditLog.FindByGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, ErrGameNotFound
+	}
+	return entries, nil
+}
+
+func (t *Tournament) AuditTrail(filter AuditFilter) ([]AuditEntry, error) {
+	if t.auditLog == nil {
+		return nil, ErrAuditNotConfigured
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultAuditLimit
+	}
+	return t.auditLog.Find(filter)
+}
+
+// AuditedGames is implemented by the games repositories recording the audit
+// entry of a change in the same transaction as the change itself. They set
+// the ID of the game and the values it has after the change in the entry.
+type AuditedGames interface {
+	SaveAudited(game *Game, entry *AuditEntry) error
+	UpdateAudited(game *Game, entry *AuditEntry) error
+	DeleteAudited(id int, entry *AuditEntry) error
+}
+
+// saveGame saves the game and records its creation.
+func (t *Tournament) saveGame(game *Game) error {
+	entry := t.auditEntry(CreateAction, nil)
+	if audited, ok := t.games.(AuditedGames); ok && entry != nil {
+		return audited.SaveAudited(game, entry)
+	}
+	if err := t.games.Save(game); err != nil {
+		return err
+	}
+	return t.record(entry, game.ID, game)
+}
+
+// updateGame replaces the recorded game and records the change.
+func (t *Tournament) updateGame(recorded, game *Game) error {
+	entry := t.auditEntry(UpdateAction, recorded)
+	if audited, ok := t.games.(AuditedGames); ok && entry != nil {
+		return audited.UpdateAudited(game, entry)
+	}
+	if err := t.games.Update(game); err != nil {
+		return err
+	}
+	return t.record(entry, game.ID, game)
+}
+
+// deleteGame removes the recorded game and records its deletion.
+func (t *Tournament) deleteGame(recorded *Game) error {
+	entry := t.auditEntry(DeleteAction, recorded)
+	if audited, ok := t.games.(AuditedGames); ok && entry != nil {
+		return audited.DeleteAudited(recorded.ID, entry)
+	}
+	if err := t.games.Delete(recorded.ID); err != nil {
+		return err
+	}
+	return t.record(entry, recorded.ID, nil)
+}
+
+// auditEntry returns the entry of the change made by the actor to the game,
+// or nil without an audit log.
+func (t *Tournament) auditEntry(action AuditAction, before *Game) *AuditEntry {
+	if t.auditLog == nil {
+		return nil
+	}
+	entry := &AuditEntry{
+		Action:    action,
+		Principal: t.actor.Principal,
+		RequestID: t.actor.RequestID,
+	}
+	// the entry keeps the values the game had at the time of the change
+	if before != nil {
+		values := *before
+		entry.Before = &values
+	}
+	return entry
+}
+
+func (t *Tournament) record(entry *AuditEntry, gameID int, after *Game) error {
+	if entry == nil {
+		return nil
+	}
+	entry.GameID = gameID
+	if after != nil {
+		values := *after
+		entry.After = &values
+	}
+	return t.auditLog.Record(entry)
+}
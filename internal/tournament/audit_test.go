@@ -0,0 +1,164 @@
+package tournament
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type AuditArray struct {
+	entries []AuditEntry
+}
+
+func (aa *AuditArray) Record(entry *AuditEntry) error {
+	entry.ID = len(aa.entries) + 1
+	entry.At = time.Now()
+	aa.entries = append(aa.entries, *entry)
+	return nil
+}
+
+func (aa *AuditArray) FindByGame(gameID int) ([]AuditEntry, error) {
+	entries := []AuditEntry{}
+	for _, entry := range aa.entries {
+		if entry.GameID == gameID {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+func (aa *AuditArray) Find(filter AuditFilter) ([]AuditEntry, error) {
+	entries := []AuditEntry{}
+	for i := len(aa.entries) - 1; i >= 0 && len(entries) < filter.Limit; i-- {
+		entry := aa.entries[i]
+		if filter.Principal != "" && entry.Principal != filter.Principal || filter.BeforeID != 0 && entry.ID >= filter.BeforeID {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func TestGameHistory(t *testing.T) {
+	tournament := NewTournament(&GamesArray{}, WithAuditLog(&AuditArray{}))
+
+	game, _ := tournament.As(Actor{Principal: "alice", RequestID: "r1"}).Play(Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 0})
+	created := *game
+	game.ScoreB = 1
+	tournament.As(Actor{Principal: "bob", RequestID: "r2"}).UpdateGame(*game)
+	tournament.As(Actor{Principal: "alice", RequestID: "r3"}).DeleteGame(game.ID)
+
+	history, err := tournament.GameHistory(game.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error getting game history: %v", err)
+	}
+	if len(history) != 3 {
+		t.Fatalf("Expected 3 changes, got %v", history)
+	}
+
+	expected := []struct {
+		action    AuditAction
+		principal string
+		requestID string
+		before    *Game
+		after     *Game
+	}{
+		{CreateAction, "alice", "r1", nil, &created},
+		{UpdateAction, "bob", "r2", &created, game},
+		{DeleteAction, "alice", "r3", game, nil},
+	}
+	for i, e := range expected {
+		got := history[i]
+		if got.Action != e.action || got.Principal != e.principal || got.RequestID != e.requestID ||
+			!reflect.DeepEqual(got.Before, e.before) || !reflect.DeepEqual(got.After, e.after) {
+			t.Errorf("Expected change %v to be %v by %v in %v from %v to %v, got %v", i, e.action, e.principal, e.requestID, e.before, e.after, got)
+		}
+	}
+
+	if _, err := tournament.GameHistory(42); err != ErrGameNotFound {
+		t.Errorf("Expected ErrGameNotFound for game without history, got %v", err)
+	}
+}
+
+// AuditedGamesArray records the audit entries of the changes it makes.
+type AuditedGamesArray struct {
+	GamesArray
+	audit *AuditArray
+	fail  bool
+}
+
+func (ga *AuditedGamesArray) SaveAudited(game *Game, entry *AuditEntry) error {
+	if ga.fail {
+		return errors.New("Save failed")
+	}
+	ga.Save(game)
+	return ga.record(entry, game.ID, game)
+}
+
+func (ga *AuditedGamesArray) UpdateAudited(game *Game, entry *AuditEntry) error {
+	if err := ga.Update(game); err != nil {
+		return err
+	}
+	return ga.record(entry, game.ID, game)
+}
+
+func (ga *AuditedGamesArray) DeleteAudited(id int, entry *AuditEntry) error {
+	if err := ga.Delete(id); err != nil {
+		return err
+	}
+	return ga.record(entry, id, nil)
+}
+
+func (ga *AuditedGamesArray) record(entry *AuditEntry, gameID int, after *Game) error {
+	entry.GameID = gameID
+	if after != nil {
+		values := *after
+		entry.After = &values
+	}
+	return ga.audit.Record(entry)
+}
+
+func TestAuditedGames(t *testing.T) {
+	games := &AuditedGamesArray{audit: &AuditArray{}}
+	tournament := NewTournament(games, WithAuditLog(games.audit))
+
+	game, _ := tournament.As(Actor{Principal: "alice"}).Play(Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 0})
+	game.ScoreB = 1
+	tournament.As(Actor{Principal: "bob"}).UpdateGame(*game)
+	tournament.DeleteGame(game.ID)
+
+	history, _ := tournament.GameHistory(game.ID)
+	if len(history) != 3 || history[0].Principal != "alice" || history[1].Before.ScoreB != 0 || history[1].After.ScoreB != 1 || history[2].After != nil {
+		t.Errorf("Expected changes recorded once by the games, got %v", history)
+	}
+
+	games.fail = true
+	if _, err := tournament.Play(Game{TeamA: "a", ScoreA: 1, TeamB: "c", ScoreB: 0}); err == nil {
+		t.Fatalf("Expected error of failed save")
+	}
+	if len(games.audit.entries) != 3 {
+		t.Errorf("Expected no change recorded for failed save, got %v", games.audit.entries)
+	}
+}
+
+func TestAuditTrail(t *testing.T) {
+	tournament := NewTournament(&GamesArray{}, WithAuditLog(&AuditArray{}))
+	tournament.As(Actor{Principal: "alice"}).Play(Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 0})
+	tournament.As(Actor{Principal: "bob"}).Play(Game{TeamA: "a", ScoreA: 2, TeamB: "c", ScoreB: 0})
+	tournament.As(Actor{Principal: "alice"}).Play(Game{TeamA: "b", ScoreA: 3, TeamB: "c", ScoreB: 0})
+
+	entries, _ := tournament.AuditTrail(AuditFilter{Principal: "alice"})
+	if len(entries) != 2 || entries[0].GameID != 3 || entries[1].GameID != 1 {
+		t.Errorf("Expected latest first changes of games 3 and 1 by alice, got %v", entries)
+	}
+
+	entries, _ = tournament.AuditTrail(AuditFilter{BeforeID: 3, Limit: 1})
+	if len(entries) != 1 || entries[0].GameID != 2 {
+		t.Errorf("Expected change of game 2 before the last one, got %v", entries)
+	}
+
+	if _, err := NewTournament(&GamesArray{}).AuditTrail(AuditFilter{}); err != ErrAuditNotConfigured {
+		t.Errorf("Expected ErrAuditNotConfigured, got %v", err)
+	}
+}
@@ -0,0 +1,227 @@
+package tournament
+
+import (
+	"errors"
+)
+
+// Bracket is a single-elimination knockout draw. Matches of round 1 are
+// played first and the winner of the match at position p of a round plays in
+// the match at position p/2 of the next round, until the final.
+type Bracket struct {
+	ID      int
+	Name    string
+	Matches []BracketMatch
+}
+
+// BracketMatch is a match of a bracket. A match of round 1 without TeamB is a
+// bye, which TeamA wins without playing.
+type BracketMatch struct {
+	Round    int
+	Position int
+	TeamA    string
+	TeamB    string
+	Winner   string
+}
+
+var ErrBracketsNotConfigured = errors.New("Brackets repository not configured")
+var ErrBracketNotFound = errors.New("Bracket not found")
+var ErrUndecidedKnockoutGame = errors.New("Knockout game needs a winner after extra time or penalties")
+
+type Brackets interface {
+	Save(bracket *Bracket) error
+	Update(bracket *Bracket) error
+	FindByID(id int) (*Bracket, error)
+	FindAll() ([]Bracket, error)
+}
+
+// WithBrackets sets the repository knockout brackets are stored in.
+func WithBrackets(brackets Brackets) Option {
+	return func(t *Tournament) {
+		t.brackets = brackets
+	}
+}
+
+// NewBracket draws the teams, listed from the top seed, into a bracket. The
+// draw is extended with byes to the next power of two and the top seeds are
+// given the byes. Seeds 1 and 2 can only meet in the final, seeds 1 to 4 in
+// the semi-finals and so on.
+func NewBracket(name string, teams []string) (*Bracket, error) {
+	if err := validateTeams(teams); err != nil {
+		return nil, err
+	}
+
+	size := 2
+	for size < len(teams) {
+		size *= 2
+	}
+
+	seeds := []int{1}
+	for len(seeds) < size {
+		next := make([]int, 0, 2*len(seeds))
+		for _, seed := range seeds {
+			next = append(next, seed, 2*len(seeds)+1-seed)
+		}
+		seeds = next
+	}
+
+	seededTeam := func(seed int) string {
+		if seed > len(teams) {
+			return ""
+		}
+		return teams[seed-1]
+	}
+	pairs := make([][2]string, 0, size/2)
+	for position := 0; position < size/2; position++ {
+		pairs = append(pairs, [2]string{seededTeam(seeds[2*position]), seededTeam(seeds[2*position+1])})
+	}
+
+	return drawBracket(name, pairs), nil
+}
+
+// drawBracket creates the bracket with the pairs, whose number must be a power
+// of two, playing the first round. A pair without the second team is a bye.
+func drawBracket(name string, pairs [][2]string) *Bracket {
+	bracket := &Bracket{Name: name}
+	for round, matches := 1, len(pairs); matches > 0; round, matches = round+1, matches/2 {
+		for position := 0; position < matches; position++ {
+			bracket.Matches = append(bracket.Matches, BracketMatch{Round: round, Position: position})
+		}
+	}
+
+	for position, pair := range pairs {
+		match := bracket.Match(1, position)
+		match.TeamA, match.TeamB = pair[0], pair[1]
+		if match.TeamB == "" {
+			bracket.advance(match, match.TeamA)
+		}
+	}
+
+	return bracket
+}
+
+// Rounds returns the number of rounds of the bracket, the last being the final.
+func (b *Bracket) Rounds() int {
+	rounds := 0
+	for _, m := range b.Matches {
+		if m.Round > rounds {
+			rounds = m.Round
+		}
+	}
+	return rounds
+}
+
+// Match returns the match at the position of the round, or nil if there is no
+// such match.
+func (b *Bracket) Match(round, position int) *BracketMatch {
+	for i := range b.Matches {
+		if b.Matches[i].Round == round && b.Matches[i].Position == position {
+			return &b.Matches[i]
+		}
+	}
+	return nil
+}
+
+// Final returns the last match of the bracket.
+func (b *Bracket) Final() *BracketMatch {
+	return b.Match(b.Rounds(), 0)
+}
+
+// IsBye tells whether TeamA goes through the match without playing.
+func (m *BracketMatch) IsBye() bool {
+	return m.Round == 1 && m.TeamB == ""
+}
+
+// IsOpen tells whether both teams of the match are known and it has not been
+// played yet.
+func (m *BracketMatch) IsOpen() bool {
+	return m.TeamA != "" && m.TeamB != "" && m.Winner == ""
+}
+
+func (b *Bracket) advance(match *BracketMatch, winner string) {
+	match.Winner = winner
+
+	next := b.Match(match.Round+1, match.Position/2)
+	if next == nil {
+		return
+	}
+	if match.Position%2 == 0 {
+		next.TeamA = winner
+	} else {
+		next.TeamB = winner
+	}
+}
+
+func (t *Tournament) CreateBracket(name string, teams []string) (*Bracket, error) {
+	if t.brackets == nil {
+		return nil, ErrBracketsNotConfigured
+	}
+	if t.archive != nil {
+		return nil, ErrSeasonClosed
+	}
+
+	bracket, err := NewBracket(name, teams)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.brackets.Save(bracket); err != nil {
+		return nil, err
+	}
+	return bracket, nil
+}
+
+func (t *Tournament) GetBracket(id int) (*Bracket, error) {
+	if t.brackets == nil {
+		return nil, ErrBracketsNotConfigured
+	}
+	return t.brackets.FindByID(id)
+}
+
+// decidedKnockoutGame tells whether the game sent its winner through to the
+// next round of a bracket.
+func (t *Tournament) decidedKnockoutGame(game *Game) (bool, error) {
+	if t.brackets == nil {
+		return false, nil
+	}
+
+	brackets, err := t.brackets.FindAll()
+	if err != nil {
+		return false, err
+	}
+
+	for i := range brackets {
+		for _, m := range brackets[i].Matches {
+			if m.Winner != "" && m.Winner == game.Winner() && sameTeams(game, &Game{TeamA: m.TeamA, TeamB: m.TeamB}) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// sameTeams tells whether the games are played between the same teams.
+func sameTeams(a, b *Game) bool {
+	return a.TeamA == b.TeamA && a.TeamB == b.TeamB || a.TeamA == b.TeamB && a.TeamB == b.TeamA
+}
+
+// findOpenBracketMatch returns the open bracket match between the teams of the
+// game, if there is one.
+func (t *Tournament) findOpenBracketMatch(game *Game) (*Bracket, *BracketMatch, error) {
+	if t.brackets == nil {
+		return nil, nil, nil
+	}
+
+	brackets, err := t.brackets.FindAll()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i := range brackets {
+		for j := range brackets[i].Matches {
+			m := &brackets[i].Matches[j]
+			if m.IsOpen() && sameTeams(game, &Game{TeamA: m.TeamA, TeamB: m.TeamB}) {
+				return &brackets[i], m, nil
+			}
+		}
+	}
+	return nil, nil, nil
+}
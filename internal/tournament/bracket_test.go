@@ -0,0 +1,189 @@
+package tournament
+
+import (
+	"reflect"
+	"testing"
+)
+
+type BracketsArray struct {
+	brackets []Bracket
+}
+
+func (ba *BracketsArray) Save(bracket *Bracket) error {
+	bracket.ID = len(ba.brackets) + 1
+	ba.brackets = append(ba.brackets, copyBracket(bracket))
+	return nil
+}
+
+func (ba *BracketsArray) Update(bracket *Bracket) error {
+	ba.brackets[bracket.ID-1] = copyBracket(bracket)
+	return nil
+}
+
+func (ba *BracketsArray) FindByID(id int) (*Bracket, error) {
+	if id < 1 || id > len(ba.brackets) {
+		return nil, ErrBracketNotFound
+	}
+	bracket := copyBracket(&ba.brackets[id-1])
+	return &bracket, nil
+}
+
+func (ba *BracketsArray) FindAll() ([]Bracket, error) {
+	brackets := make([]Bracket, 0, len(ba.brackets))
+	for i := range ba.brackets {
+		brackets = append(brackets, copyBracket(&ba.brackets[i]))
+	}
+	return brackets, nil
+}
+
+func copyBracket(bracket *Bracket) Bracket {
+	c := *bracket
+	c.Matches = append([]BracketMatch(nil), bracket.Matches...)
+	return c
+}
+
+var bracketTestData = []struct {
+	testName    string
+	teams       []string
+	firstRound  []BracketMatch
+	secondRound []BracketMatch
+}{
+	{
+		testName: "full draw",
+		teams:    []string{"s1", "s2", "s3", "s4", "s5", "s6", "s7", "s8"},
+		firstRound: []BracketMatch{
+			{Round: 1, Position: 0, TeamA: "s1", TeamB: "s8"},
+			{Round: 1, Position: 1, TeamA: "s4", TeamB: "s5"},
+			{Round: 1, Position: 2, TeamA: "s2", TeamB: "s7"},
+			{Round: 1, Position: 3, TeamA: "s3", TeamB: "s6"},
+		},
+		secondRound: []BracketMatch{
+			{Round: 2, Position: 0},
+			{Round: 2, Position: 1},
+		},
+	},
+	{
+		testName: "top seeds get byes",
+		teams:    []string{"s1", "s2", "s3", "s4", "s5"},
+		firstRound: []BracketMatch{
+			{Round: 1, Position: 0, TeamA: "s1", Winner: "s1"},
+			{Round: 1, Position: 1, TeamA: "s4", TeamB: "s5"},
+			{Round: 1, Position: 2, TeamA: "s2", Winner: "s2"},
+			{Round: 1, Position: 3, TeamA: "s3", Winner: "s3"},
+		},
+		secondRound: []BracketMatch{
+			{Round: 2, Position: 0, TeamA: "s1"},
+			{Round: 2, Position: 1, TeamA: "s2", TeamB: "s3"},
+		},
+	},
+	{
+		testName: "two teams",
+		teams:    []string{"s1", "s2"},
+		firstRound: []BracketMatch{
+			{Round: 1, Position: 0, TeamA: "s1", TeamB: "s2"},
+		},
+	},
+}
+
+func TestNewBracket(t *testing.T) {
+	for _, testData := range bracketTestData {
+		bracket, err := NewBracket(testData.testName, testData.teams)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", testData.testName, err)
+		}
+
+		rounds := map[int][]BracketMatch{}
+		for _, m := range bracket.Matches {
+			rounds[m.Round] = append(rounds[m.Round], m)
+		}
+		if !reflect.DeepEqual(rounds[1], testData.firstRound) {
+			t.Errorf("%v: expected first round %v, got %v", testData.testName, testData.firstRound, rounds[1])
+		}
+		if !reflect.DeepEqual(rounds[2], testData.secondRound) {
+			t.Errorf("%v: expected second round %v, got %v", testData.testName, testData.secondRound, rounds[2])
+		}
+	}
+
+	if _, err := NewBracket("one team", []string{"s1"}); err != ErrNotEnoughTeams {
+		t.Errorf("Expected ErrNotEnoughTeams, got %v", err)
+	}
+}
+
+func TestBracketAdvancement(t *testing.T) {
+	tournament := NewTournament(&GamesArray{}, WithBrackets(&BracketsArray{}))
+	bracket, err := tournament.CreateBracket("cup", []string{"a", "b", "c"})
+	if err != nil {
+		t.Fatalf("Unexpected error creating bracket: %v", err)
+	}
+
+	_, err = tournament.Play(Game{TeamA: "b", ScoreA: 1, TeamB: "c", ScoreB: 1})
+	if err != ErrUndecidedKnockoutGame {
+		t.Errorf("Expected ErrUndecidedKnockoutGame, got %v", err)
+	}
+	if _, err := tournament.GetStats("b"); err != ErrTeamNotFound {
+		t.Errorf("Expected undecided knockout game not to be recorded")
+	}
+
+	_, err = tournament.Play(Game{TeamA: "c", ScoreA: 1, TeamB: "b", ScoreB: 1, DecidedIn: Penalties, PenaltiesA: 5, PenaltiesB: 4})
+	if err != nil {
+		t.Fatalf("Unexpected error playing semi-final: %v", err)
+	}
+
+	bracket, _ = tournament.GetBracket(bracket.ID)
+	final := bracket.Final()
+	if final.TeamA != "a" || final.TeamB != "c" || !final.IsOpen() {
+		t.Fatalf("Expected open final between 'a' and 'c', got %v", final)
+	}
+
+	_, err = tournament.Play(Game{TeamA: "a", ScoreA: 2, TeamB: "c", ScoreB: 3, DecidedIn: ExtraTime})
+	if err != nil {
+		t.Fatalf("Unexpected error playing final: %v", err)
+	}
+
+	bracket, _ = tournament.GetBracket(bracket.ID)
+	if winner := bracket.Final().Winner; winner != "c" {
+		t.Errorf("Expected 'c' to win the bracket, got '%v'", winner)
+	}
+
+	if _, err := tournament.Play(Game{TeamA: "a", ScoreA: 0, TeamB: "c", ScoreB: 0}); err != nil {
+		t.Errorf("Expected game outside of bracket to be recorded, got %v", err)
+	}
+}
+
+func TestDecidedKnockoutGameCorrections(t *testing.T) {
+	tournament := NewTournament(&GamesArray{}, WithBrackets(&BracketsArray{}))
+	tournament.CreateBracket("cup", []string{"a", "b"})
+	game, _ := tournament.Play(Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 0})
+
+	game.ScoreA = 2
+	if _, err := tournament.UpdateGame(*game); err != nil {
+		t.Errorf("Expected score correction keeping the winner, got %v", err)
+	}
+
+	game.ScoreB = 3
+	if _, err := tournament.UpdateGame(*game); err != ErrKnockoutGameDecided {
+		t.Errorf("Expected ErrKnockoutGameDecided changing the winner, got %v", err)
+	}
+	if err := tournament.DeleteGame(game.ID); err != ErrKnockoutGameDecided {
+		t.Errorf("Expected ErrKnockoutGameDecided deleting the game, got %v", err)
+	}
+}
+
+func TestGameWinner(t *testing.T) {
+	games := []struct {
+		game   Game
+		winner string
+	}{
+		{Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 0}, "a"},
+		{Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 1}, ""},
+		{Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 2, DecidedIn: ExtraTime}, "b"},
+		{Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 1, DecidedIn: Penalties, PenaltiesA: 3, PenaltiesB: 4}, "b"},
+		{Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 1, PenaltiesA: 3, PenaltiesB: 4}, ""},
+	}
+
+	for _, g := range games {
+		if winner := g.game.Winner(); winner != g.winner {
+			t.Errorf("Game %v - expected winner '%v', got '%v'", g.game, g.winner, winner)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package tournament
+
+import (
+	"errors"
+)
+
+// Format of a competition.
+type Format string
+
+const (
+	LeagueFormat     Format = "league"
+	KnockoutFormat   Format = "knockout"
+	SwissFormat      Format = "swiss"
+	GroupStageFormat Format = "groups"
+)
+
+// Competition is a league, cup or any other event the games are played in.
+// Every competition has its own games, fixtures and standings.
+type Competition struct {
+	ID      int
+	Name    string
+	Season  string
+	Format  Format
+	Scoring ScoringRules
+	Zones   Zones
+	// Teams entered into the competition, optional for competitions whose
+	// teams are only known from their games
+	Teams []string
+	// Closed is set once the season is over and its standings are frozen
+	Closed bool
+}
+
+// DefaultCompetitionID identifies the competition that holds the games
+// recorded before competitions were introduced.
+const DefaultCompetitionID = 1
+
+var ErrCompetitionNotFound = errors.New("Competition not found")
+var ErrUnknownFormat = errors.New("Unknown competition format")
+
+type Competitions interface {
+	Save(competition *Competition) error
+	FindByID(id int) (*Competition, error)
+	FindAll() ([]Competition, error)
+	// UpdateScoring replaces the scoring rules of the competition.
+	UpdateScoring(id int, rules ScoringRules) error
+	// Close marks the competition as closed and stores its final standings.
+	Close(id int, standings []Stats) error
+	FindStandings(id int) ([]Stats, error)
+}
+
+// Organizer runs several competitions, each one with its own Tournament.
+type Organizer struct {
+	competitions  Competitions
+	newTournament func(competition *Competition) *Tournament
+}
+
+// NewOrganizer returns an organizer of the competitions. The newTournament
+// function creates the Tournament of a competition, with repositories holding
+// the data of that competition only.
+func NewOrganizer(competitions Competitions, newTournament func(competition *Competition) *Tournament) *Organizer {
+	return &Organizer{
+		competitions:  competitions,
+		newTournament: newTournament,
+	}
+}
+
+func (o *Organizer) CreateCompetition(competition Competition) (*Competition, error) {
+	if !competition.Format.IsValid() {
+		return nil, ErrUnknownFormat
+	}
+	if !competition.Zones.IsValid() {
+		return nil, ErrInvalidZones
+	}
+	if err := o.competitions.Save(&competition); err != nil {
+		return nil, err
+	}
+	return &competition, nil
+}
+
+func (o *Organizer) GetCompetition(id int) (*Competition, error) {
+	return o.competitions.FindByID(id)
+}
+
+func (o *Organizer) GetCompetitions() ([]Competition, error) {
+	return o.competitions.FindAll()
+}
+
+// UpdateScoring changes how the games of the competition are scored, e.g. of
+// the default competition which has no scoring rules of its own.
+func (o *Organizer) UpdateScoring(competitionID int, rules ScoringRules) error {
+	return o.competitions.UpdateScoring(competitionID, rules)
+}
+
+// Tournament returns the Tournament of the competition, marking the zones of
+// the competition in its standings and accepting games of its teams only,
+// when the teams are entered.
+func (o *Organizer) Tournament(competitionID int) (*Tournament, error) {
+	competition, err := o.competitions.FindByID(competitionID)
+	if err != nil {
+		return nil, err
+	}
+	t := o.newTournament(competition)
+	t.zones = competition.Zones
+	if len(competition.Teams) > 0 {
+		t.teams = competition.Teams
+	}
+	if competition.Closed {
+		standings, err := o.competitions.FindStandings(competitionID)
+		if err != nil {
+			return nil, err
+		}
+		t.archive = append([]Stats{}, standings...)
+	}
+	return t, nil
+}
+
+// IsValid tells whether the format is one of the known formats.
+func (f Format) IsValid() bool {
+	switch f {
+	case LeagueFormat, KnockoutFormat, SwissFormat, GroupStageFormat:
+		return true
+	default:
+		return false
+	}
+}
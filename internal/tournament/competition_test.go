@@ -0,0 +1,115 @@
+package tournament
+
+import (
+	"testing"
+)
+
+type CompetitionsArray struct {
+	competitions []Competition
+	standings    map[int][]Stats
+}
+
+func (ca *CompetitionsArray) Save(competition *Competition) error {
+	competition.ID = len(ca.competitions) + 1
+	ca.competitions = append(ca.competitions, *competition)
+	return nil
+}
+
+func (ca *CompetitionsArray) FindByID(id int) (*Competition, error) {
+	if id < 1 || id > len(ca.competitions) {
+		return nil, ErrCompetitionNotFound
+	}
+	competition := ca.competitions[id-1]
+	return &competition, nil
+}
+
+func (ca *CompetitionsArray) FindAll() ([]Competition, error) {
+	return ca.competitions, nil
+}
+
+func (ca *CompetitionsArray) UpdateScoring(id int, rules ScoringRules) error {
+	if id < 1 || id > len(ca.competitions) {
+		return ErrCompetitionNotFound
+	}
+	ca.competitions[id-1].Scoring = rules
+	return nil
+}
+
+func (ca *CompetitionsArray) Close(id int, standings []Stats) error {
+	if id < 1 || id > len(ca.competitions) {
+		return ErrCompetitionNotFound
+	}
+	if ca.standings == nil {
+		ca.standings = map[int][]Stats{}
+	}
+	ca.competitions[id-1].Closed = true
+	ca.standings[id] = standings
+	return nil
+}
+
+func (ca *CompetitionsArray) FindStandings(id int) ([]Stats, error) {
+	return ca.standings[id], nil
+}
+
+func newTestOrganizer() *Organizer {
+	games := map[int]*GamesArray{}
+	return NewOrganizer(&CompetitionsArray{}, func(c *Competition) *Tournament {
+		if games[c.ID] == nil {
+			games[c.ID] = &GamesArray{}
+		}
+		return NewTournament(games[c.ID], WithScoringRules(c.Scoring))
+	})
+}
+
+func TestCompetitionsAreSeparate(t *testing.T) {
+	organizer := newTestOrganizer()
+	league, _ := organizer.CreateCompetition(Competition{Name: "league", Season: "2026", Format: LeagueFormat, Scoring: DefaultScoringRules})
+	cup, _ := organizer.CreateCompetition(Competition{Name: "cup", Season: "2026", Format: LeagueFormat, Scoring: TwoPointScoringRules})
+
+	leagueTournament, _ := organizer.Tournament(league.ID)
+	leagueTournament.Play(Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 0})
+	cupTournament, _ := organizer.Tournament(cup.ID)
+	cupTournament.Play(Game{TeamA: "a", ScoreA: 2, TeamB: "c", ScoreB: 0})
+
+	leagueTournament, _ = organizer.Tournament(league.ID)
+	if stats, _ := leagueTournament.GetStats("a"); stats.Played != 1 || stats.Points != 3 {
+		t.Errorf("Expected 1 game and 3 points in league, got %v", stats)
+	}
+	if _, err := leagueTournament.GetStats("c"); err != ErrTeamNotFound {
+		t.Errorf("Expected cup team not to be found in league, got %v", err)
+	}
+
+	cupTournament, _ = organizer.Tournament(cup.ID)
+	if stats, _ := cupTournament.GetStats("a"); stats.Played != 1 || stats.Points != 2 {
+		t.Errorf("Expected 1 game and 2 points in cup, got %v", stats)
+	}
+}
+
+func TestCompetitionErrors(t *testing.T) {
+	organizer := newTestOrganizer()
+
+	if _, err := organizer.CreateCompetition(Competition{Name: "league", Format: "ladder"}); err != ErrUnknownFormat {
+		t.Errorf("Expected ErrUnknownFormat, got %v", err)
+	}
+	if _, err := organizer.Tournament(42); err != ErrCompetitionNotFound {
+		t.Errorf("Expected ErrCompetitionNotFound, got %v", err)
+	}
+}
+
+func TestUpdateScoring(t *testing.T) {
+	organizer := newTestOrganizer()
+	competition, _ := organizer.CreateCompetition(Competition{Name: "Default", Format: LeagueFormat, Scoring: DefaultScoringRules})
+
+	if err := organizer.UpdateScoring(competition.ID, TwoPointScoringRules); err != nil {
+		t.Fatalf("Unexpected error updating scoring rules: %v", err)
+	}
+	tournament, _ := organizer.Tournament(competition.ID)
+	tournament.Play(Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 0})
+	if stats, _ := tournament.GetStats("a"); stats.Points != TwoPointScoringRules.Win {
+		t.Errorf("Expected win scored with the updated rules, got %v", stats)
+	}
+
+	if err := organizer.UpdateScoring(42, TwoPointScoringRules); err != ErrCompetitionNotFound {
+		t.Errorf("Expected ErrCompetitionNotFound, got %v", err)
+	}
+}
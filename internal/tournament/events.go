@@ -0,0 +1,153 @@
+package tournament
+
+import (
+	"sync"
+	"time"
+)
+
+// EventBufferSize is the number of events a subscriber can fall behind
+// before missing events.
+const EventBufferSize = 64
+
+// EventType is the kind of change a tournament publishes.
+type EventType string
+
+const (
+	GameCreated    EventType = "game.created"
+	GameUpdated    EventType = "game.updated"
+	GameDeleted    EventType = "game.deleted"
+	FixtureUpdated EventType = "fixture.updated"
+	PointsAdjusted EventType = "points.adjusted"
+	LeaderChanged  EventType = "standings.leader_changed"
+
+	LiveGameStarted   EventType = "live.started"
+	LiveScoreUpdated  EventType = "live.updated"
+	LiveGameEnded     EventType = "live.ended"
+	LiveGameCancelled EventType = "live.cancelled"
+)
+
+// Event tells about a change of the games, fixtures or point adjustments of a
+// competition once it is stored, or about a change of its live games. Game is
+// the game after the change, or before it when deleted, and the recorded game
+// of an ended live game.
+type Event struct {
+	// ID is set by the bus, increasing with every event it publishes
+	ID            int64
+	Type          EventType
+	CompetitionID int
+	Game          *Game
+	Fixture       *Fixture
+	Adjustment    *PointAdjustment
+	LiveGame      *LiveGame
+	At            time.Time
+}
+
+type Publisher interface {
+	Publish(event Event)
+}
+
+// WithPublisher sets the publisher of the changes made by the tournament.
+func WithPublisher(publisher Publisher) Option {
+	return func(t *Tournament) {
+		t.publisher = publisher
+	}
+}
+
+func (t *Tournament) publish(eventType EventType, event Event) {
+	if t.publisher == nil {
+		return
+	}
+	event.Type = eventType
+	// the event keeps the values at the time of the change
+	if event.Game != nil {
+		game := *event.Game
+		event.Game = &game
+	}
+	if event.Fixture != nil {
+		fixture := *event.Fixture
+		event.Fixture = &fixture
+	}
+	if event.Adjustment != nil {
+		adjustment := *event.Adjustment
+		event.Adjustment = &adjustment
+	}
+	if event.LiveGame != nil {
+		live := *event.LiveGame
+		event.LiveGame = &live
+	}
+	t.publisher.Publish(event)
+}
+
+// EventBus delivers the events published by the tournaments to the
+// subscribers in the same process.
+type EventBus struct {
+	mu          sync.Mutex
+	lastID      int64
+	subscribers map[chan Event]bool
+}
+
+func NewEventBus() *EventBus {
+	return &EventBus{subscribers: map[chan Event]bool{}}
+}
+
+// ForCompetition returns the publisher of the events of the competition.
+func (b *EventBus) ForCompetition(competitionID int) Publisher {
+	return competitionPublisher{b, competitionID}
+}
+
+type competitionPublisher struct {
+	bus           *EventBus
+	competitionID int
+}
+
+func (p competitionPublisher) Publish(event Event) {
+	event.CompetitionID = p.competitionID
+	p.bus.Publish(event)
+}
+
+// Publish sets the ID and time of the event and sends it to every
+// subscriber. Subscribers that fall behind by more than EventBufferSize
+// events miss the event rather than block the publisher.
+func (b *EventBus) Publish(event Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lastID++
+	event.ID = b.lastID
+	if event.At.IsZero() {
+		event.At = time.Now()
+	}
+	for events := range b.subscribers {
+		select {
+		case events <- event:
+		default:
+		}
+	}
+}
+
+// LastID returns the ID of the latest event, 0 when none was published yet.
+func (b *EventBus) LastID() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.lastID
+}
+
+// Subscribe returns the channel receiving the events published from now on,
+// and the function ending the subscription which closes the channel.
+func (b *EventBus) Subscribe() (<-chan Event, func()) {
+	events := make(chan Event, EventBufferSize)
+
+	b.mu.Lock()
+	b.subscribers[events] = true
+	b.mu.Unlock()
+
+	var once sync.Once
+	return events, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers, events)
+			b.mu.Unlock()
+			close(events)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package tournament
+
+import "testing"
+
+func TestEventBus(t *testing.T) {
+	bus := NewEventBus()
+	events, cancel := bus.Subscribe()
+
+	bus.ForCompetition(2).Publish(Event{Type: GameCreated})
+	bus.Publish(Event{Type: GameDeleted})
+
+	first, second := <-events, <-events
+	if first.ID != 1 || first.Type != GameCreated || first.CompetitionID != 2 || first.At.IsZero() {
+		t.Errorf("Unexpected first event: %v", first)
+	}
+	if second.ID != 2 || second.Type != GameDeleted {
+		t.Errorf("Unexpected second event: %v", second)
+	}
+	if bus.LastID() != 2 {
+		t.Errorf("Expected last event ID 2, got %v", bus.LastID())
+	}
+
+	cancel()
+	cancel()
+	if _, ok := <-events; ok {
+		t.Errorf("Expected events closed after the subscription ended")
+	}
+	bus.Publish(Event{Type: GameUpdated})
+}
+
+func TestEventBusSlowSubscriber(t *testing.T) {
+	bus := NewEventBus()
+	events, cancel := bus.Subscribe()
+	defer cancel()
+
+	for i := 0; i < EventBufferSize+1; i++ {
+		bus.Publish(Event{Type: GameCreated})
+	}
+	if len(events) != EventBufferSize {
+		t.Errorf("Expected %v buffered events, got %v", EventBufferSize, len(events))
+	}
+}
+
+type EventsArray struct {
+	events []Event
+}
+
+func (ea *EventsArray) Publish(event Event) {
+	ea.events = append(ea.events, event)
+}
+
+func TestTournamentEvents(t *testing.T) {
+	published := &EventsArray{}
+	tournament := NewTournament(&GamesArray{}, WithPublisher(published))
+
+	game, _ := tournament.Play(Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 0})
+	game.ScoreB = 1
+	tournament.UpdateGame(*game)
+	tournament.DeleteGame(game.ID)
+	tournament.Play(Game{TeamA: "a", ScoreA: 1, TeamB: "a", ScoreB: 0})
+
+	expectedTypes := []EventType{GameCreated, GameUpdated, GameDeleted}
+	if len(published.events) != len(expectedTypes) {
+		t.Fatalf("Expected %v events, got %v", len(expectedTypes), published.events)
+	}
+	for i, eventType := range expectedTypes {
+		event := published.events[i]
+		if event.Type != eventType || event.Game == nil || event.Game.ID != game.ID {
+			t.Errorf("Expected %v event of game %v, got %v", eventType, game.ID, event)
+		}
+	}
+	if published.events[0].Game.ScoreB != 0 {
+		t.Errorf("Expected the created event to keep the score at creation, got %v", published.events[0].Game)
+	}
+}
@@ -0,0 +1,344 @@
+package tournament
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Fixture is a game scheduled in a round. A fixture without AwayTeam means
+// HomeTeam has a bye in that round.
+type Fixture struct {
+	ID       int
+	Round    int
+	HomeTeam string
+	AwayTeam string
+	Status   FixtureStatus
+	// GameID is the game recorded as the result of the fixture, which counts
+	// in the standings only while the fixture is completed.
+	GameID int
+}
+
+// FixtureStatus tells whether the game of the fixture is still to be played.
+type FixtureStatus int
+
+const (
+	Scheduled FixtureStatus = iota
+	InProgress
+	Completed
+	Postponed
+	Cancelled
+	Abandoned
+)
+
+var fixtureStatusNames = []string{"scheduled", "inProgress", "completed", "postponed", "cancelled", "abandoned"}
+
+func (s FixtureStatus) String() string {
+	if s < 0 || int(s) >= len(fixtureStatusNames) {
+		return fmt.Sprintf("FixtureStatus(%d)", int(s))
+	}
+	return fixtureStatusNames[s]
+}
+
+// ParseFixtureStatus returns the status with the given name.
+func ParseFixtureStatus(name string) (FixtureStatus, error) {
+	for i, n := range fixtureStatusNames {
+		if n == name {
+			return FixtureStatus(i), nil
+		}
+	}
+	return Scheduled, &UnknownNameError{Kind: "fixture status", Name: name}
+}
+
+var ErrFixturesNotConfigured = errors.New("Fixtures repository not configured")
+var ErrFixturesExist = errors.New("Fixtures already scheduled")
+var ErrNotEnoughTeams = errors.New("At least two teams are needed")
+var ErrDuplicateTeam = errors.New("Team listed more than once")
+var ErrEmptyTeam = errors.New("Team name must not be empty")
+var ErrFixtureNotFound = errors.New("Fixture not found")
+var ErrByeFixture = errors.New("Fixture is a bye")
+var ErrFixtureCancelled = errors.New("Fixture cancelled")
+var ErrResultRequired = errors.New("Fixture is completed by recording its result")
+
+type Fixtures interface {
+	// Save stores the fixtures, setting their IDs.
+	Save(fixtures []Fixture) error
+	FindAll() ([]Fixture, error)
+	FindByID(id int) (*Fixture, error)
+	Update(fixture *Fixture) error
+}
+
+// FixtureGames is implemented by the games repositories storing the game of a
+// fixture and completing the fixture with it in a single transaction. They set
+// the GameID of the fixture and record the entry like AuditedGames.
+type FixtureGames interface {
+	SaveFixtureGame(game *Game, fixture *Fixture, entry *AuditEntry) error
+	UpdateFixtureGame(game *Game, fixture *Fixture, entry *AuditEntry) error
+}
+
+// WithFixtures sets the repository the schedule is stored in.
+func WithFixtures(fixtures Fixtures) Option {
+	return func(t *Tournament) {
+		t.fixtures = fixtures
+	}
+}
+
+// IsBye tells whether the fixture is a bye for HomeTeam.
+func (f Fixture) IsBye() bool {
+	return f.AwayTeam == ""
+}
+
+// ScheduleRoundRobin creates and stores the fixtures in which every team plays
+// every other team once, or twice with swapped home and away when double is
+// set.
+func (t *Tournament) ScheduleRoundRobin(teams []string, double bool) ([]Fixture, error) {
+	if t.fixtures == nil {
+		return nil, ErrFixturesNotConfigured
+	}
+	if t.archive != nil {
+		return nil, ErrSeasonClosed
+	}
+	if err := validateTeams(teams); err != nil {
+		return nil, err
+	}
+
+	existing, err := t.fixtures.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return nil, ErrFixturesExist
+	}
+
+	fixtures := RoundRobin(teams, double)
+	if err := t.fixtures.Save(fixtures); err != nil {
+		return nil, err
+	}
+	return fixtures, nil
+}
+
+func (t *Tournament) GetFixtures() ([]Fixture, error) {
+	if t.fixtures == nil {
+		return nil, ErrFixturesNotConfigured
+	}
+	return t.fixtures.FindAll()
+}
+
+// GetFixturesByStatus returns the fixtures with the status, e.g. the scheduled
+// ones to show the upcoming games and the completed ones to show the results.
+func (t *Tournament) GetFixturesByStatus(status FixtureStatus) ([]Fixture, error) {
+	fixtures, err := t.GetFixtures()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []Fixture{}
+	for _, f := range fixtures {
+		if f.Status == status {
+			result = append(result, f)
+		}
+	}
+	return result, nil
+}
+
+// RecordResult records the game of the fixture, played by its home team as
+// TeamA and its away team as TeamB, and completes the fixture. Recording the
+// result of a fixture again corrects its game.
+func (t *Tournament) RecordResult(fixtureID int, game Game) (*Game, error) {
+	if t.fixtures == nil {
+		return nil, ErrFixturesNotConfigured
+	}
+	fixture, err := t.fixtures.FindByID(fixtureID)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case fixture.IsBye():
+		return nil, ErrByeFixture
+	case fixture.Status == Cancelled:
+		return nil, ErrFixtureCancelled
+	}
+
+	game.TeamA, game.TeamB = fixture.HomeTeam, fixture.AwayTeam
+	fixture.Status = Completed
+	var recorded *Game
+	if fixture.GameID != 0 {
+		game.ID = fixture.GameID
+		recorded, err = t.updateGameWith(game, func(recorded, game *Game) error {
+			return t.updateFixtureGame(recorded, game, fixture)
+		})
+	} else {
+		recorded, err = t.playWith(game, func(game *Game) error {
+			return t.saveFixtureGame(game, fixture)
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	t.publish(FixtureUpdated, Event{Fixture: fixture, Game: recorded})
+	return recorded, nil
+}
+
+// saveFixtureGame saves the game of the fixture and completes the fixture
+// with it, in a single transaction when the games repository supports it.
+func (t *Tournament) saveFixtureGame(game *Game, fixture *Fixture) error {
+	if fixtureGames, ok := t.games.(FixtureGames); ok {
+		return fixtureGames.SaveFixtureGame(game, fixture, t.auditEntry(CreateAction, nil))
+	}
+	if err := t.saveGame(game); err != nil {
+		return err
+	}
+	fixture.GameID = game.ID
+	return t.fixtures.Update(fixture)
+}
+
+// updateFixtureGame corrects the game of the fixture and completes the
+// fixture, in a single transaction when the games repository supports it.
+func (t *Tournament) updateFixtureGame(recorded, game *Game, fixture *Fixture) error {
+	if fixtureGames, ok := t.games.(FixtureGames); ok {
+		return fixtureGames.UpdateFixtureGame(game, fixture, t.auditEntry(UpdateAction, recorded))
+	}
+	if err := t.updateGame(recorded, game); err != nil {
+		return err
+	}
+	return t.fixtures.Update(fixture)
+}
+
+// UpdateFixtureStatus changes the status of the fixture. A fixture is
+// completed by recording its result only. The recorded game of a fixture no
+// longer completed, e.g. abandoned, does not count in the standings.
+func (t *Tournament) UpdateFixtureStatus(fixtureID int, status FixtureStatus) (*Fixture, error) {
+	if t.fixtures == nil {
+		return nil, ErrFixturesNotConfigured
+	}
+	if t.archive != nil {
+		return nil, ErrSeasonClosed
+	}
+	fixture, err := t.fixtures.FindByID(fixtureID)
+	if err != nil {
+		return nil, err
+	}
+	if status == Completed && fixture.Status != Completed {
+		return nil, ErrResultRequired
+	}
+
+	fixture.Status = status
+	if err := t.fixtures.Update(fixture); err != nil {
+		return nil, err
+	}
+	t.publish(FixtureUpdated, Event{Fixture: fixture})
+	return fixture, nil
+}
+
+// countedGames returns the games counting in the standings, leaving out the
+// games of fixtures which are not completed.
+func (t *Tournament) countedGames(games []Game) ([]Game, error) {
+	if t.fixtures == nil {
+		return games, nil
+	}
+	fixtures, err := t.fixtures.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	notCompleted := map[int]bool{}
+	for _, f := range fixtures {
+		if f.GameID != 0 && f.Status != Completed {
+			notCompleted[f.GameID] = true
+		}
+	}
+	if len(notCompleted) == 0 {
+		return games, nil
+	}
+
+	counted := make([]Game, 0, len(games))
+	for _, game := range games {
+		if !notCompleted[game.ID] {
+			counted = append(counted, game)
+		}
+	}
+	return counted, nil
+}
+
+// unlinkFixture schedules again the fixture whose game is deleted.
+func (t *Tournament) unlinkFixture(gameID int) error {
+	if t.fixtures == nil {
+		return nil
+	}
+	fixtures, err := t.fixtures.FindAll()
+	if err != nil {
+		return err
+	}
+	for i := range fixtures {
+		if fixtures[i].GameID == gameID {
+			fixtures[i].GameID = 0
+			fixtures[i].Status = Scheduled
+			return t.fixtures.Update(&fixtures[i])
+		}
+	}
+	return nil
+}
+
+// RoundRobin builds the round-robin schedule of the teams using the circle
+// method. Home and away games alternate so that every team hosts half of its
+// games, give or take one. With an odd number of teams one team has a bye in
+// every round. When double is set the schedule is played a second time with
+// home and away swapped.
+func RoundRobin(teams []string, double bool) []Fixture {
+	circle := append([]string(nil), teams...)
+	if len(circle)%2 == 1 {
+		// the bye stays in place so that all teams rotate around it
+		circle = append([]string{""}, circle...)
+	}
+
+	n := len(circle)
+	rounds := n - 1
+	fixtures := make([]Fixture, 0, rounds*n/2)
+	for round := 0; round < rounds; round++ {
+		for i := 0; i < n/2; i++ {
+			home, away := circle[i], circle[n-1-i]
+			if i == 0 && round%2 == 1 || i > 0 && i%2 == 1 {
+				home, away = away, home
+			}
+			switch {
+			case home == "":
+				fixtures = append(fixtures, Fixture{Round: round + 1, HomeTeam: away})
+			case away == "":
+				fixtures = append(fixtures, Fixture{Round: round + 1, HomeTeam: home})
+			default:
+				fixtures = append(fixtures, Fixture{Round: round + 1, HomeTeam: home, AwayTeam: away})
+			}
+		}
+		// keep the first team in place and rotate all others clockwise
+		circle = append([]string{circle[0], circle[n-1]}, circle[1:n-1]...)
+	}
+
+	if double {
+		firstLeg := fixtures
+		for _, f := range firstLeg {
+			second := Fixture{Round: f.Round + rounds, HomeTeam: f.AwayTeam, AwayTeam: f.HomeTeam}
+			if f.IsBye() {
+				second = Fixture{Round: f.Round + rounds, HomeTeam: f.HomeTeam}
+			}
+			fixtures = append(fixtures, second)
+		}
+	}
+
+	return fixtures
+}
+
+func validateTeams(teams []string) error {
+	if len(teams) < 2 {
+		return ErrNotEnoughTeams
+	}
+	seen := make(map[string]bool, len(teams))
+	for _, team := range teams {
+		if team == "" {
+			return ErrEmptyTeam
+		}
+		if seen[team] {
+			return ErrDuplicateTeam
+		}
+		seen[team] = true
+	}
+	return nil
+}
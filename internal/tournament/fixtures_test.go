@@ -0,0 +1,300 @@
+package tournament
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+type FixturesArray struct {
+	fixtures []Fixture
+	// updateErr fails the updates when set
+	updateErr error
+}
+
+func (fa *FixturesArray) Save(fixtures []Fixture) error {
+	for i := range fixtures {
+		fixtures[i].ID = len(fa.fixtures) + 1
+		fa.fixtures = append(fa.fixtures, fixtures[i])
+	}
+	return nil
+}
+
+func (fa *FixturesArray) FindAll() ([]Fixture, error) {
+	return append([]Fixture{}, fa.fixtures...), nil
+}
+
+func (fa *FixturesArray) FindByID(id int) (*Fixture, error) {
+	for _, f := range fa.fixtures {
+		if f.ID == id {
+			return &f, nil
+		}
+	}
+	return nil, ErrFixtureNotFound
+}
+
+func (fa *FixturesArray) Update(fixture *Fixture) error {
+	if fa.updateErr != nil {
+		return fa.updateErr
+	}
+	for i := range fa.fixtures {
+		if fa.fixtures[i].ID == fixture.ID {
+			fa.fixtures[i] = *fixture
+			return nil
+		}
+	}
+	return ErrFixtureNotFound
+}
+
+func TestRoundRobin(t *testing.T) {
+	for n := 2; n <= 12; n++ {
+		teams := make([]string, 0, n)
+		for i := 0; i < n; i++ {
+			teams = append(teams, fmt.Sprintf("t%d", i))
+		}
+
+		fixtures := RoundRobin(teams, false)
+
+		rounds := n - 1 + n%2
+		meetings := map[[2]string]int{}
+		home := map[string]int{}
+		away := map[string]int{}
+		byes := map[string]int{}
+		perRound := map[int]map[string]bool{}
+		for _, f := range fixtures {
+			if f.Round < 1 || f.Round > rounds {
+				t.Errorf("%d teams: unexpected round %d", n, f.Round)
+			}
+			if perRound[f.Round] == nil {
+				perRound[f.Round] = map[string]bool{}
+			}
+			for _, team := range []string{f.HomeTeam, f.AwayTeam} {
+				if team != "" && perRound[f.Round][team] {
+					t.Errorf("%d teams: team '%s' scheduled twice in round %d", n, team, f.Round)
+				}
+				perRound[f.Round][team] = true
+			}
+			if f.IsBye() {
+				byes[f.HomeTeam]++
+				continue
+			}
+			home[f.HomeTeam]++
+			away[f.AwayTeam]++
+			pair := [2]string{f.HomeTeam, f.AwayTeam}
+			if pair[0] > pair[1] {
+				pair[0], pair[1] = pair[1], pair[0]
+			}
+			meetings[pair]++
+		}
+
+		if len(meetings) != n*(n-1)/2 {
+			t.Errorf("%d teams: expected %d pairings, got %d", n, n*(n-1)/2, len(meetings))
+		}
+		for pair, count := range meetings {
+			if count != 1 {
+				t.Errorf("%d teams: %v meet %d times", n, pair, count)
+			}
+		}
+		for _, team := range teams {
+			if diff := home[team] - away[team]; diff < -1 || diff > 1 {
+				t.Errorf("%d teams: team '%s' unbalanced with %d home and %d away games", n, team, home[team], away[team])
+			}
+			if n%2 == 1 && byes[team] != 1 {
+				t.Errorf("%d teams: team '%s' expected 1 bye, got %d", n, team, byes[team])
+			}
+		}
+	}
+}
+
+func TestDoubleRoundRobin(t *testing.T) {
+	teams := []string{"a", "b", "c"}
+	fixtures := RoundRobin(teams, true)
+	firstLeg := RoundRobin(teams, false)
+
+	if len(fixtures) != 2*len(firstLeg) {
+		t.Fatalf("Expected %d fixtures, got %d", 2*len(firstLeg), len(fixtures))
+	}
+	for i, f := range firstLeg {
+		second := fixtures[len(firstLeg)+i]
+		if second.Round != f.Round+3 {
+			t.Errorf("Expected round %d, got %d", f.Round+3, second.Round)
+		}
+		if !f.IsBye() && (second.HomeTeam != f.AwayTeam || second.AwayTeam != f.HomeTeam) {
+			t.Errorf("Expected %v to swap home and away of %v", second, f)
+		}
+		if f.IsBye() && second.HomeTeam != f.HomeTeam {
+			t.Errorf("Expected %v to repeat the bye of %v", second, f)
+		}
+	}
+}
+
+func TestScheduleRoundRobin(t *testing.T) {
+	tournament := NewTournament(&GamesArray{}, WithFixtures(&FixturesArray{}))
+
+	if _, err := tournament.ScheduleRoundRobin([]string{"a"}, false); err != ErrNotEnoughTeams {
+		t.Errorf("Expected ErrNotEnoughTeams, got %v", err)
+	}
+	if _, err := tournament.ScheduleRoundRobin([]string{"a", "b", "a"}, false); err != ErrDuplicateTeam {
+		t.Errorf("Expected ErrDuplicateTeam, got %v", err)
+	}
+
+	scheduled, err := tournament.ScheduleRoundRobin([]string{"a", "b", "c", "d"}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error scheduling fixtures: %v", err)
+	}
+	got, _ := tournament.GetFixtures()
+	if len(got) != 6 || len(scheduled) != 6 {
+		t.Errorf("Expected 6 fixtures, got %d", len(got))
+	}
+
+	if _, err := tournament.ScheduleRoundRobin([]string{"a", "b"}, false); err != ErrFixturesExist {
+		t.Errorf("Expected ErrFixturesExist, got %v", err)
+	}
+}
+
+func TestFixturesNotConfigured(t *testing.T) {
+	tournament := NewTournament(&GamesArray{})
+	if _, err := tournament.GetFixtures(); err != ErrFixturesNotConfigured {
+		t.Errorf("Expected ErrFixturesNotConfigured, got %v", err)
+	}
+}
+
+func TestRecordResult(t *testing.T) {
+	tournament := NewTournament(&GamesArray{}, WithFixtures(&FixturesArray{}))
+	tournament.ScheduleRoundRobin([]string{"a", "b", "c"}, false)
+	fixtures, _ := tournament.GetFixtures()
+	var bye, ab *Fixture
+	for i := range fixtures {
+		switch {
+		case fixtures[i].IsBye() && bye == nil:
+			bye = &fixtures[i]
+		case !fixtures[i].IsBye() && ab == nil:
+			ab = &fixtures[i]
+		}
+	}
+
+	if _, err := tournament.RecordResult(bye.ID, Game{}); err != ErrByeFixture {
+		t.Errorf("Expected ErrByeFixture, got %v", err)
+	}
+	if _, err := tournament.RecordResult(100, Game{}); err != ErrFixtureNotFound {
+		t.Errorf("Expected ErrFixtureNotFound, got %v", err)
+	}
+
+	game, err := tournament.RecordResult(ab.ID, Game{TeamA: "x", ScoreA: 2, ScoreB: 1})
+	if err != nil {
+		t.Fatalf("Unexpected error recording result: %v", err)
+	}
+	if game.TeamA != ab.HomeTeam || game.TeamB != ab.AwayTeam {
+		t.Errorf("Expected game of fixture teams, got %v", game)
+	}
+	results, _ := tournament.GetFixturesByStatus(Completed)
+	if len(results) != 1 || results[0].ID != ab.ID || results[0].GameID != game.ID {
+		t.Errorf("Expected completed fixture with game, got %v", results)
+	}
+	upcoming, _ := tournament.GetFixturesByStatus(Scheduled)
+	if len(upcoming) != len(fixtures)-1 {
+		t.Errorf("Expected %d scheduled fixtures, got %v", len(fixtures)-1, upcoming)
+	}
+
+	corrected, err := tournament.RecordResult(ab.ID, Game{ScoreA: 3, ScoreB: 1})
+	if err != nil || corrected.ID != game.ID {
+		t.Errorf("Expected game of fixture corrected, got %v, %v", corrected, err)
+	}
+	if stats, _ := tournament.GetStats(ab.HomeTeam); stats.Played != 1 || stats.GoalsFor != 3 {
+		t.Errorf("Expected corrected game in stats, got %v", stats)
+	}
+}
+
+// FixtureGamesArray completes the fixtures together with their games, keeping
+// both unchanged when the fixture update fails.
+type FixtureGamesArray struct {
+	GamesArray
+	fixtures *FixturesArray
+}
+
+func (ga *FixtureGamesArray) SaveFixtureGame(game *Game, fixture *Fixture, entry *AuditEntry) error {
+	if ga.fixtures.updateErr != nil {
+		return ga.fixtures.updateErr
+	}
+	ga.Save(game)
+	fixture.GameID = game.ID
+	return ga.fixtures.Update(fixture)
+}
+
+func (ga *FixtureGamesArray) UpdateFixtureGame(game *Game, fixture *Fixture, entry *AuditEntry) error {
+	if ga.fixtures.updateErr != nil {
+		return ga.fixtures.updateErr
+	}
+	if err := ga.Update(game); err != nil {
+		return err
+	}
+	return ga.fixtures.Update(fixture)
+}
+
+func TestRecordResultFixtureUpdateFails(t *testing.T) {
+	fixtures := &FixturesArray{}
+	games := &FixtureGamesArray{fixtures: fixtures}
+	published := &EventsArray{}
+	tournament := NewTournament(games, WithFixtures(fixtures), WithPublisher(published))
+	scheduled, _ := tournament.ScheduleRoundRobin([]string{"a", "b"}, false)
+	id := scheduled[0].ID
+
+	fixtures.updateErr = errors.New("Update failed")
+	if _, err := tournament.RecordResult(id, Game{ScoreA: 2, ScoreB: 1}); err != fixtures.updateErr {
+		t.Errorf("Expected error of the fixture update, got %v", err)
+	}
+	if len(games.games) != 0 || len(published.events) != 0 {
+		t.Errorf("Expected no game saved nor published, got %v, %v", games.games, published.events)
+	}
+
+	fixtures.updateErr = nil
+	game, _ := tournament.RecordResult(id, Game{ScoreA: 2, ScoreB: 1})
+	if fixture, _ := fixtures.FindByID(id); fixture.Status != Completed || fixture.GameID != game.ID {
+		t.Fatalf("Expected fixture completed with game %v, got %v", game.ID, fixture)
+	}
+	published.events = nil
+	fixtures.updateErr = errors.New("Update failed")
+	tournament.RecordResult(id, Game{ScoreA: 0, ScoreB: 3})
+	if got, _ := tournament.GetGame(game.ID); !reflect.DeepEqual(got, game) || len(published.events) != 0 {
+		t.Errorf("Expected game of the fixture kept as %v without events, got %v, %v", game, got, published.events)
+	}
+}
+
+func TestUpdateFixtureStatus(t *testing.T) {
+	tournament := NewTournament(&GamesArray{}, WithFixtures(&FixturesArray{}))
+	fixtures, _ := tournament.ScheduleRoundRobin([]string{"a", "b"}, false)
+	id := fixtures[0].ID
+
+	if _, err := tournament.UpdateFixtureStatus(id, Completed); err != ErrResultRequired {
+		t.Errorf("Expected ErrResultRequired, got %v", err)
+	}
+
+	tournament.RecordResult(id, Game{ScoreA: 1, ScoreB: 0})
+	fixture, err := tournament.UpdateFixtureStatus(id, Abandoned)
+	if err != nil || fixture.Status != Abandoned {
+		t.Fatalf("Expected abandoned fixture, got %v, %v", fixture, err)
+	}
+	if stats, _ := tournament.GetAllStats(); len(stats) != 0 {
+		t.Errorf("Expected game of abandoned fixture not counted, got %v", stats)
+	}
+
+	tournament.UpdateFixtureStatus(id, Cancelled)
+	if _, err := tournament.RecordResult(id, Game{ScoreA: 1, ScoreB: 0}); err != ErrFixtureCancelled {
+		t.Errorf("Expected ErrFixtureCancelled, got %v", err)
+	}
+}
+
+func TestDeletedGameUnlinksFixture(t *testing.T) {
+	tournament := NewTournament(&GamesArray{}, WithFixtures(&FixturesArray{}))
+	fixtures, _ := tournament.ScheduleRoundRobin([]string{"a", "b"}, false)
+	game, _ := tournament.RecordResult(fixtures[0].ID, Game{ScoreA: 1, ScoreB: 0})
+
+	if err := tournament.DeleteGame(game.ID); err != nil {
+		t.Fatalf("Unexpected error deleting game: %v", err)
+	}
+	got, _ := tournament.GetFixtures()
+	if got[0].Status != Scheduled || got[0].GameID != 0 {
+		t.Errorf("Expected fixture scheduled again, got %v", got[0])
+	}
+}
@@ -0,0 +1,124 @@
+package tournament
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// GameResult of a game for the team it is looked at from.
+type GameResult string
+
+const (
+	AnyResult  GameResult = ""
+	WinResult  GameResult = "win"
+	DrawResult GameResult = "draw"
+	LossResult GameResult = "loss"
+)
+
+// ResultFor returns the result of the game for the team, as counted in the
+// stats with the rules: both teams lose a double forfeit, and a game won by
+// penalty shoot-out is a draw unless the rules score overtime.
+func (g *Game) ResultFor(team string, rules ScoringRules) GameResult {
+	winner := g.Winner()
+	switch {
+	case g.Outcome == DoubleForfeit:
+		return LossResult
+	case winner == "" || g.ScoreA == g.ScoreB && !rules.Overtime:
+		return DrawResult
+	case winner == team:
+		return WinResult
+	default:
+		return LossResult
+	}
+}
+
+// GameSort is the time games are listed by.
+type GameSort string
+
+const (
+	SortByPlayedAt   GameSort = "playedAt"
+	SortByRecordedAt GameSort = "recordedAt"
+)
+
+const DefaultGamesLimit = 50
+const MaxGamesLimit = 500
+
+var ErrInvalidGameFilter = errors.New("Opponent and result filters need a team")
+var ErrInvalidCursor = errors.New("Invalid cursor")
+
+// GameFilter selects the games to list. Zero values do not filter.
+type GameFilter struct {
+	Team string
+	// Opponent of Team
+	Opponent string
+	// From and To limit when the games were played, To excluded
+	From time.Time
+	To   time.Time
+	// Result for Team
+	Result GameResult
+	// Scoring the results are counted with, set by ListGames to the rules of
+	// the stats
+	Scoring    ScoringRules
+	SortBy     GameSort
+	Descending bool
+	// After is the position following which the games are listed
+	After *GameCursor
+	Limit int
+}
+
+// GameCursor is the position of a game in a sorted list of games.
+type GameCursor struct {
+	Time time.Time
+	ID   int
+}
+
+// GamePage is a part of the list of games. NextCursor is empty on the last
+// page.
+type GamePage struct {
+	Games      []Game
+	NextCursor string
+}
+
+// ListGames returns the page of games selected by the filter, by default the
+// first DefaultGamesLimit games in the order they were played.
+func (t *Tournament) ListGames(filter GameFilter) (*GamePage, error) {
+	if filter.Team == "" && (filter.Opponent != "" || filter.Result != AnyResult) {
+		return nil, ErrInvalidGameFilter
+	}
+	if filter.SortBy == "" {
+		filter.SortBy = SortByPlayedAt
+	}
+	if filter.Limit <= 0 || filter.Limit > MaxGamesLimit {
+		filter.Limit = DefaultGamesLimit
+	}
+	filter.Scoring = t.rules
+	return t.games.Find(filter)
+}
+
+// CursorOf returns the position of the game in the list sorted by sortBy.
+func CursorOf(game *Game, sortBy GameSort) *GameCursor {
+	if sortBy == SortByRecordedAt {
+		return &GameCursor{Time: game.RecordedAt, ID: game.ID}
+	}
+	return &GameCursor{Time: game.PlayedAt, ID: game.ID}
+}
+
+// String encodes the cursor to be passed back by clients.
+func (c *GameCursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d", c.Time.UnixNano(), c.ID)))
+}
+
+func ParseGameCursor(s string) (*GameCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var nanos int64
+	var id int
+	if n, err := fmt.Sscanf(string(decoded), "%d:%d", &nanos, &id); err != nil || n != 2 {
+		return nil, ErrInvalidCursor
+	}
+	return &GameCursor{Time: time.Unix(0, nanos), ID: id}, nil
+}
@@ -0,0 +1,132 @@
+package tournament
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func playedOn(day int, game Game) Game {
+	game.PlayedAt = time.Date(2026, 5, day, 15, 0, 0, 0, time.UTC)
+	return game
+}
+
+func gameIDs(games []Game) []int {
+	ids := []int{}
+	for _, game := range games {
+		ids = append(ids, game.ID)
+	}
+	return ids
+}
+
+func TestListGames(t *testing.T) {
+	tournament := NewTournament(&GamesArray{})
+	tournament.Play(playedOn(3, Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 0}))
+	tournament.Play(playedOn(1, Game{TeamA: "c", ScoreA: 2, TeamB: "a", ScoreB: 2}))
+	tournament.Play(playedOn(2, Game{TeamA: "b", ScoreA: 3, TeamB: "c", ScoreB: 1}))
+	tournament.Play(playedOn(4, Game{TeamA: "b", ScoreA: 0, TeamB: "a", ScoreB: 2}))
+
+	var listGamesTestData = []struct {
+		testName string
+		filter   GameFilter
+		ids      []int
+	}{
+		{"all games in the order played", GameFilter{}, []int{2, 3, 1, 4}},
+		{"latest first", GameFilter{Descending: true}, []int{4, 1, 3, 2}},
+		{"games of team", GameFilter{Team: "a"}, []int{2, 1, 4}},
+		{"games against opponent", GameFilter{Team: "a", Opponent: "b"}, []int{1, 4}},
+		{"wins of team", GameFilter{Team: "a", Result: WinResult}, []int{1, 4}},
+		{"draws of team", GameFilter{Team: "a", Result: DrawResult}, []int{2}},
+		{"losses of team", GameFilter{Team: "b", Result: LossResult}, []int{1, 4}},
+		{"games in date range", GameFilter{From: time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC), To: time.Date(2026, 5, 4, 0, 0, 0, 0, time.UTC)}, []int{3, 1}},
+		{"games in the order recorded", GameFilter{SortBy: SortByRecordedAt}, []int{1, 2, 3, 4}},
+	}
+
+	for _, testData := range listGamesTestData {
+		page, err := tournament.ListGames(testData.filter)
+		if err != nil {
+			t.Fatalf("%v: unexpected error listing games: %v", testData.testName, err)
+		}
+		if !reflect.DeepEqual(gameIDs(page.Games), testData.ids) {
+			t.Errorf("%v: expected games %v, got %v", testData.testName, testData.ids, gameIDs(page.Games))
+		}
+	}
+
+	if _, err := tournament.ListGames(GameFilter{Result: WinResult}); err != ErrInvalidGameFilter {
+		t.Errorf("Expected ErrInvalidGameFilter for result without team, got %v", err)
+	}
+}
+
+func TestGameResultFor(t *testing.T) {
+	var resultTestData = []struct {
+		testName string
+		game     Game
+		rules    ScoringRules
+		resultA  GameResult
+		resultB  GameResult
+	}{
+		{"win", Game{TeamA: "a", ScoreA: 2, TeamB: "b", ScoreB: 1}, DefaultScoringRules, WinResult, LossResult},
+		{"draw", Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 1}, DefaultScoringRules, DrawResult, DrawResult},
+		{"win by penalties", Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 1, DecidedIn: Penalties, PenaltiesA: 3, PenaltiesB: 4}, OvertimeScoringRules, LossResult, WinResult},
+		{"penalties without overtime", Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 1, DecidedIn: Penalties, PenaltiesA: 3, PenaltiesB: 4}, DefaultScoringRules, DrawResult, DrawResult},
+		{"win in extra time", Game{TeamA: "a", ScoreA: 2, TeamB: "b", ScoreB: 1, DecidedIn: ExtraTime}, DefaultScoringRules, WinResult, LossResult},
+		{"forfeit", Game{TeamA: "a", ScoreA: 0, TeamB: "b", ScoreB: 3, Outcome: Forfeit}, DefaultScoringRules, LossResult, WinResult},
+		{"double forfeit", Game{TeamA: "a", ScoreA: 0, TeamB: "b", ScoreB: 0, Outcome: DoubleForfeit}, DefaultScoringRules, LossResult, LossResult},
+	}
+
+	for _, testData := range resultTestData {
+		if result := testData.game.ResultFor("a", testData.rules); result != testData.resultA {
+			t.Errorf("%v: expected %v for team a, got %v", testData.testName, testData.resultA, result)
+		}
+		if result := testData.game.ResultFor("b", testData.rules); result != testData.resultB {
+			t.Errorf("%v: expected %v for team b, got %v", testData.testName, testData.resultB, result)
+		}
+	}
+}
+
+func TestListGamesResultsScoredLikeStats(t *testing.T) {
+	game := Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 1, DecidedIn: Penalties, PenaltiesA: 4, PenaltiesB: 3}
+	for _, rules := range []ScoringRules{DefaultScoringRules, OvertimeScoringRules} {
+		tournament := NewTournament(&GamesArray{}, WithScoringRules(rules))
+		if _, err := tournament.Play(game); err != nil {
+			t.Fatalf("Unexpected error playing game: %v", err)
+		}
+
+		stats, _ := tournament.GetStats("a")
+		page, _ := tournament.ListGames(GameFilter{Team: "a", Result: WinResult})
+		if len(page.Games) != stats.Won {
+			t.Errorf("Expected %v wins listed like the stats with %v, got %v", stats.Won, rules, page.Games)
+		}
+	}
+}
+
+func TestListGamesPages(t *testing.T) {
+	tournament := NewTournament(&GamesArray{})
+	for day := 1; day <= 5; day++ {
+		tournament.Play(playedOn(day, Game{TeamA: "a", ScoreA: day, TeamB: "b", ScoreB: 0}))
+	}
+
+	pages := [][]int{}
+	filter := GameFilter{Limit: 2, Descending: true}
+	for {
+		page, err := tournament.ListGames(filter)
+		if err != nil {
+			t.Fatalf("Unexpected error listing games: %v", err)
+		}
+		pages = append(pages, gameIDs(page.Games))
+		if page.NextCursor == "" {
+			break
+		}
+		if filter.After, err = ParseGameCursor(page.NextCursor); err != nil {
+			t.Fatalf("Unexpected error parsing cursor: %v", err)
+		}
+	}
+
+	if expected := [][]int{{5, 4}, {3, 2}, {1}}; !reflect.DeepEqual(pages, expected) {
+		t.Errorf("Expected pages %v, got %v", expected, pages)
+	}
+
+	if _, err := ParseGameCursor("not a cursor"); err != ErrInvalidCursor {
+		t.Errorf("Expected ErrInvalidCursor, got %v", err)
+	}
+}
@@ -0,0 +1,194 @@
+package tournament
+
+import (
+	"errors"
+)
+
+// GroupStage is a competition format in which the teams play a round-robin
+// within their groups and the best placed teams of every group qualify for a
+// knockout bracket.
+type GroupStage struct {
+	ID         int
+	Name       string
+	Groups     []Group
+	Qualifiers int
+	// BracketID of the knockout phase, zero until all group games are played.
+	BracketID int
+}
+
+type Group struct {
+	Name  string
+	Teams []string
+}
+
+// GroupTable is the standings of a group.
+type GroupTable struct {
+	Group     string
+	Standings []Stats
+}
+
+var ErrGroupStagesNotConfigured = errors.New("Group stages repository not configured")
+var ErrGroupStageNotFound = errors.New("Group stage not found")
+var ErrNotEnoughQualifiers = errors.New("At least two teams must qualify for the knockout phase")
+var ErrGroupTooSmall = errors.New("Every group needs at least two teams and more teams than qualifiers")
+
+type GroupStages interface {
+	Save(stage *GroupStage) error
+	Update(stage *GroupStage) error
+	FindByID(id int) (*GroupStage, error)
+	FindAll() ([]GroupStage, error)
+}
+
+// WithGroupStages sets the repository group stages are stored in.
+func WithGroupStages(groupStages GroupStages) Option {
+	return func(t *Tournament) {
+		t.groupStages = groupStages
+	}
+}
+
+func (t *Tournament) CreateGroupStage(name string, groups []Group, qualifiers int) (*GroupStage, error) {
+	if t.groupStages == nil {
+		return nil, ErrGroupStagesNotConfigured
+	}
+	if t.brackets == nil {
+		return nil, ErrBracketsNotConfigured
+	}
+	if t.archive != nil {
+		return nil, ErrSeasonClosed
+	}
+
+	if qualifiers < 1 || qualifiers*len(groups) < 2 {
+		return nil, ErrNotEnoughQualifiers
+	}
+	allTeams := []string{}
+	for _, group := range groups {
+		if len(group.Teams) < 2 || len(group.Teams) <= qualifiers {
+			return nil, ErrGroupTooSmall
+		}
+		allTeams = append(allTeams, group.Teams...)
+	}
+	if err := validateTeams(allTeams); err != nil {
+		return nil, err
+	}
+
+	stage := &GroupStage{Name: name, Groups: groups, Qualifiers: qualifiers}
+	if err := t.groupStages.Save(stage); err != nil {
+		return nil, err
+	}
+	return stage, nil
+}
+
+func (t *Tournament) GetGroupStage(id int) (*GroupStage, error) {
+	if t.groupStages == nil {
+		return nil, ErrGroupStagesNotConfigured
+	}
+	return t.groupStages.FindByID(id)
+}
+
+// GetGroupTables returns the standings of every group of the stage, computed
+// from the games played between the teams of the group.
+func (t *Tournament) GetGroupTables(stage *GroupStage) ([]GroupTable, error) {
+	allGames, err := t.allCountedGames()
+	if err != nil {
+		return nil, err
+	}
+	return t.groupTables(stage, allGames), nil
+}
+
+func (t *Tournament) groupTables(stage *GroupStage, allGames []Game) []GroupTable {
+	tables := make([]GroupTable, 0, len(stage.Groups))
+	for _, group := range stage.Groups {
+		tables = append(tables, GroupTable{Group: group.Name, Standings: t.miniLeague(group.Teams, allGames)})
+	}
+	return tables
+}
+
+// IsComplete tells whether every team played all other teams of its group.
+func (s *GroupStage) IsComplete(games []Game) bool {
+	played := map[[2]string]bool{}
+	for _, game := range games {
+		played[pairKey(game.TeamA, game.TeamB)] = true
+	}
+
+	for _, group := range s.Groups {
+		for i, teamA := range group.Teams {
+			for _, teamB := range group.Teams[i+1:] {
+				if !played[pairKey(teamA, teamB)] {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// drawCompletedGroupStages creates the knockout brackets of the group stages
+// whose group games have all been played.
+func (t *Tournament) drawCompletedGroupStages() error {
+	if t.groupStages == nil {
+		return nil
+	}
+
+	stages, err := t.groupStages.FindAll()
+	if err != nil {
+		return err
+	}
+
+	var allGames []Game
+	for i := range stages {
+		stage := &stages[i]
+		if stage.BracketID != 0 {
+			continue
+		}
+
+		if allGames == nil {
+			if allGames, err = t.games.FindAll(); err != nil {
+				return err
+			}
+		}
+		if !stage.IsComplete(allGames) {
+			continue
+		}
+
+		bracket := knockoutDraw(stage.Name, t.groupTables(stage, allGames), stage.Qualifiers)
+		if err := t.brackets.Save(bracket); err != nil {
+			return err
+		}
+		stage.BracketID = bracket.ID
+		if err := t.groupStages.Update(stage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// knockoutDraw creates the bracket of the teams qualified from the groups.
+// With two qualifiers from pairs of groups the winner of a group plays the
+// runner-up of the other group (A1 v B2, B1 v A2) and the group winners are
+// in opposite halves of the bracket. Otherwise the group winners are seeded
+// first, followed by the runners-up and so on.
+func knockoutDraw(name string, tables []GroupTable, qualifiers int) *Bracket {
+	if qualifiers == 2 && isPowerOfTwo(len(tables)) && len(tables) > 1 {
+		top := make([][2]string, 0, len(tables)/2)
+		bottom := make([][2]string, 0, len(tables)/2)
+		for i := 0; i < len(tables); i += 2 {
+			a, b := tables[i].Standings, tables[i+1].Standings
+			top = append(top, [2]string{a[0].Team, b[1].Team})
+			bottom = append(bottom, [2]string{b[0].Team, a[1].Team})
+		}
+		return drawBracket(name, append(top, bottom...))
+	}
+
+	seeds := []string{}
+	for place := 0; place < qualifiers; place++ {
+		for _, table := range tables {
+			seeds = append(seeds, table.Standings[place].Team)
+		}
+	}
+	bracket, _ := NewBracket(name, seeds)
+	return bracket
+}
+
+func isPowerOfTwo(n int) bool {
+	return n > 0 && n&(n-1) == 0
+}
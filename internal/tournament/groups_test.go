@@ -0,0 +1,120 @@
+package tournament
+
+import (
+	"reflect"
+	"testing"
+)
+
+type GroupStagesArray struct {
+	stages []GroupStage
+}
+
+func (ga *GroupStagesArray) Save(stage *GroupStage) error {
+	stage.ID = len(ga.stages) + 1
+	ga.stages = append(ga.stages, *stage)
+	return nil
+}
+
+func (ga *GroupStagesArray) Update(stage *GroupStage) error {
+	ga.stages[stage.ID-1] = *stage
+	return nil
+}
+
+func (ga *GroupStagesArray) FindByID(id int) (*GroupStage, error) {
+	if id < 1 || id > len(ga.stages) {
+		return nil, ErrGroupStageNotFound
+	}
+	stage := ga.stages[id-1]
+	return &stage, nil
+}
+
+func (ga *GroupStagesArray) FindAll() ([]GroupStage, error) {
+	return append([]GroupStage(nil), ga.stages...), nil
+}
+
+func TestGroupStageKnockout(t *testing.T) {
+	brackets := &BracketsArray{}
+	tournament := NewTournament(&GamesArray{}, WithBrackets(brackets), WithGroupStages(&GroupStagesArray{}))
+
+	stage, err := tournament.CreateGroupStage("cup", []Group{
+		{Name: "A", Teams: []string{"a1", "a2", "a3"}},
+		{Name: "B", Teams: []string{"b1", "b2", "b3"}},
+	}, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error creating group stage: %v", err)
+	}
+
+	games := []Game{
+		{TeamA: "a1", ScoreA: 2, TeamB: "a2", ScoreB: 0},
+		{TeamA: "a2", ScoreA: 1, TeamB: "a3", ScoreB: 0},
+		{TeamA: "a3", ScoreA: 0, TeamB: "a1", ScoreB: 0},
+		{TeamA: "b3", ScoreA: 3, TeamB: "b1", ScoreB: 0},
+		{TeamA: "b2", ScoreA: 1, TeamB: "b3", ScoreB: 2},
+	}
+	for _, game := range games {
+		tournament.Play(game)
+	}
+
+	stage, _ = tournament.GetGroupStage(stage.ID)
+	if stage.BracketID != 0 {
+		t.Fatalf("Expected no bracket before all group games are played")
+	}
+
+	tournament.Play(Game{TeamA: "b1", ScoreA: 1, TeamB: "b2", ScoreB: 1})
+
+	tables, _ := tournament.GetGroupTables(stage)
+	if ranking := teamNames(tables[1].Standings); !reflect.DeepEqual(ranking, []string{"b3", "b2", "b1"}) {
+		t.Errorf("Expected group B ranking [b3 b2 b1], got %v", ranking)
+	}
+
+	stage, _ = tournament.GetGroupStage(stage.ID)
+	bracket, err := tournament.GetBracket(stage.BracketID)
+	if err != nil {
+		t.Fatalf("Expected bracket to be drawn once all group games are played, got %v", err)
+	}
+	expected := []BracketMatch{
+		{Round: 1, Position: 0, TeamA: "a1", TeamB: "b2"},
+		{Round: 1, Position: 1, TeamA: "b3", TeamB: "a2"},
+		{Round: 2, Position: 0},
+	}
+	if !reflect.DeepEqual(bracket.Matches, expected) {
+		t.Errorf("Expected bracket %v, got %v", expected, bracket.Matches)
+	}
+}
+
+func TestGroupStageSeededKnockout(t *testing.T) {
+	tables := []GroupTable{
+		{Group: "A", Standings: []Stats{{Team: "a1"}, {Team: "a2"}}},
+		{Group: "B", Standings: []Stats{{Team: "b1"}, {Team: "b2"}}},
+		{Group: "C", Standings: []Stats{{Team: "c1"}, {Team: "c2"}}},
+	}
+
+	bracket := knockoutDraw("cup", tables, 1)
+	expected := []BracketMatch{
+		{Round: 1, Position: 0, TeamA: "a1", Winner: "a1"},
+		{Round: 1, Position: 1, TeamA: "b1", TeamB: "c1"},
+		{Round: 2, Position: 0, TeamA: "a1"},
+	}
+	if !reflect.DeepEqual(bracket.Matches, expected) {
+		t.Errorf("Expected bracket %v, got %v", expected, bracket.Matches)
+	}
+}
+
+func TestCreateGroupStageErrors(t *testing.T) {
+	tournament := NewTournament(&GamesArray{}, WithBrackets(&BracketsArray{}), WithGroupStages(&GroupStagesArray{}))
+
+	_, err := tournament.CreateGroupStage("cup", []Group{{Name: "A", Teams: []string{"a", "b", "c"}}}, 1)
+	if err != ErrNotEnoughQualifiers {
+		t.Errorf("Expected ErrNotEnoughQualifiers, got %v", err)
+	}
+
+	_, err = tournament.CreateGroupStage("cup", []Group{{Name: "A", Teams: []string{"a", "b"}}, {Name: "B", Teams: []string{"c", "d"}}}, 2)
+	if err != ErrGroupTooSmall {
+		t.Errorf("Expected ErrGroupTooSmall, got %v", err)
+	}
+
+	_, err = tournament.CreateGroupStage("cup", []Group{{Name: "A", Teams: []string{"a", "b"}}, {Name: "B", Teams: []string{"a", "c"}}}, 1)
+	if err != ErrDuplicateTeam {
+		t.Errorf("Expected ErrDuplicateTeam, got %v", err)
+	}
+}
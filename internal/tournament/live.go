@@ -0,0 +1,224 @@
+package tournament
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+var ErrLiveGamesNotConfigured = errors.New("Live games not configured")
+var ErrLiveGameNotFound = errors.New("Live game not found")
+
+// LiveGame is a game being played. Its score is provisional until the game
+// ends and is played in the tournament.
+type LiveGame struct {
+	ID        int
+	Game      Game
+	StartedAt time.Time
+	UpdatedAt time.Time
+}
+
+// LiveGames keeps the games being played in memory, shared by the
+// tournaments of a competition.
+type LiveGames struct {
+	store         *liveStore
+	competitionID int
+}
+
+type liveStore struct {
+	mu     sync.Mutex
+	lastID int
+	games  map[int]map[int]LiveGame
+}
+
+// NewLiveGames returns the live games of the default competition.
+func NewLiveGames() *LiveGames {
+	return &LiveGames{&liveStore{games: map[int]map[int]LiveGame{}}, DefaultCompetitionID}
+}
+
+// ForCompetition returns the live games of the competition.
+func (l *LiveGames) ForCompetition(competitionID int) *LiveGames {
+	return &LiveGames{l.store, competitionID}
+}
+
+func (l *LiveGames) save(live *LiveGame) {
+	l.store.mu.Lock()
+	defer l.store.mu.Unlock()
+
+	if live.ID == 0 {
+		l.store.lastID++
+		live.ID = l.store.lastID
+	}
+	if l.store.games[l.competitionID] == nil {
+		l.store.games[l.competitionID] = map[int]LiveGame{}
+	}
+	l.store.games[l.competitionID][live.ID] = *live
+}
+
+func (l *LiveGames) find(id int) (*LiveGame, error) {
+	l.store.mu.Lock()
+	defer l.store.mu.Unlock()
+
+	live, ok := l.store.games[l.competitionID][id]
+	if !ok {
+		return nil, ErrLiveGameNotFound
+	}
+	return &live, nil
+}
+
+// update stores the game unless it ended or was cancelled meanwhile.
+func (l *LiveGames) update(live *LiveGame) error {
+	l.store.mu.Lock()
+	defer l.store.mu.Unlock()
+
+	if _, ok := l.store.games[l.competitionID][live.ID]; !ok {
+		return ErrLiveGameNotFound
+	}
+	l.store.games[l.competitionID][live.ID] = *live
+	return nil
+}
+
+// remove takes the game out, so that it ends or is cancelled once only.
+func (l *LiveGames) remove(id int) (*LiveGame, error) {
+	l.store.mu.Lock()
+	defer l.store.mu.Unlock()
+
+	live, ok := l.store.games[l.competitionID][id]
+	if !ok {
+		return nil, ErrLiveGameNotFound
+	}
+	delete(l.store.games[l.competitionID], id)
+	return &live, nil
+}
+
+func (l *LiveGames) findAll() []LiveGame {
+	l.store.mu.Lock()
+	defer l.store.mu.Unlock()
+
+	games := make([]LiveGame, 0, len(l.store.games[l.competitionID]))
+	for _, live := range l.store.games[l.competitionID] {
+		games = append(games, live)
+	}
+	sort.Slice(games, func(i, j int) bool {
+		return games[i].ID < games[j].ID
+	})
+	return games
+}
+
+// WithLiveGames sets the games being played in the competition.
+func WithLiveGames(live *LiveGames) Option {
+	return func(t *Tournament) {
+		t.live = live
+	}
+}
+
+// StartLiveGame starts the game with its initial score, validated like the
+// games played in the tournament.
+func (t *Tournament) StartLiveGame(game Game) (*LiveGame, error) {
+	if t.live == nil {
+		return nil, ErrLiveGamesNotConfigured
+	}
+	if t.archive != nil {
+		return nil, ErrSeasonClosed
+	}
+	game.ID = 0
+	game.Outcome = Played
+	if err := t.validateGame(&game); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	live := &LiveGame{Game: game, StartedAt: now, UpdatedAt: now}
+	t.live.save(live)
+	t.publish(LiveGameStarted, Event{LiveGame: live})
+	return live, nil
+}
+
+// UpdateLiveGame changes the score of the live game. Its teams stay the same.
+func (t *Tournament) UpdateLiveGame(id int, game Game) (*LiveGame, error) {
+	if t.live == nil {
+		return nil, ErrLiveGamesNotConfigured
+	}
+	live, err := t.live.find(id)
+	if err != nil {
+		return nil, err
+	}
+
+	game.ID = 0
+	game.TeamA, game.TeamB = live.Game.TeamA, live.Game.TeamB
+	game.Outcome = Played
+	if err := t.validateGame(&game); err != nil {
+		return nil, err
+	}
+
+	live.Game = game
+	live.UpdatedAt = time.Now()
+	if err := t.live.update(live); err != nil {
+		return nil, err
+	}
+	t.publish(LiveScoreUpdated, Event{LiveGame: live})
+	return live, nil
+}
+
+// EndLiveGame plays the live game in the tournament with its latest score.
+// The game stays live when it cannot be played, e.g. when a knockout game
+// has no winner yet.
+func (t *Tournament) EndLiveGame(id int) (*Game, error) {
+	if t.live == nil {
+		return nil, ErrLiveGamesNotConfigured
+	}
+	live, err := t.live.remove(id)
+	if err != nil {
+		return nil, err
+	}
+
+	played, err := t.Play(live.Game)
+	if err != nil {
+		t.live.save(live)
+		return nil, err
+	}
+	t.publish(LiveGameEnded, Event{LiveGame: live, Game: played})
+	return played, nil
+}
+
+// CancelLiveGame drops the live game without playing it.
+func (t *Tournament) CancelLiveGame(id int) error {
+	if t.live == nil {
+		return ErrLiveGamesNotConfigured
+	}
+	live, err := t.live.remove(id)
+	if err != nil {
+		return err
+	}
+	t.publish(LiveGameCancelled, Event{LiveGame: live})
+	return nil
+}
+
+// GetLiveGames returns the games being played, the earliest started first.
+func (t *Tournament) GetLiveGames() ([]LiveGame, error) {
+	if t.live == nil {
+		return nil, ErrLiveGamesNotConfigured
+	}
+	return t.live.findAll(), nil
+}
+
+// LiveStandings returns the provisional standings, as if the live games
+// ended with their current scores.
+func (t *Tournament) LiveStandings() ([]Stats, error) {
+	if t.live == nil {
+		return nil, ErrLiveGamesNotConfigured
+	}
+	if t.archive != nil {
+		return t.GetAllStats()
+	}
+
+	allGames, err := t.allCountedGames()
+	if err != nil {
+		return nil, err
+	}
+	for _, live := range t.live.findAll() {
+		allGames = append(allGames, live.Game)
+	}
+	return t.standings(allGames)
+}
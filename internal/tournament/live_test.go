@@ -0,0 +1,83 @@
+package tournament
+
+import "testing"
+
+func TestLiveGame(t *testing.T) {
+	published := &EventsArray{}
+	games := &GamesArray{}
+	tournament := NewTournament(games, WithLiveGames(NewLiveGames()), WithPublisher(published))
+	tournament.Play(Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 1})
+
+	live, err := tournament.StartLiveGame(Game{TeamA: "b", TeamB: "c"})
+	if err != nil {
+		t.Fatalf("Unexpected error starting live game: %v", err)
+	}
+	if _, err := tournament.UpdateLiveGame(live.ID, Game{TeamA: "x", ScoreA: 2, TeamB: "y", ScoreB: 0}); err != nil {
+		t.Fatalf("Unexpected error updating live game: %v", err)
+	}
+
+	standings, _ := tournament.LiveStandings()
+	if standings[0].Team != "b" || standings[0].Points != 4 {
+		t.Errorf("Expected 'b' leading the live standings on 4 points, got %v", standings)
+	}
+	if stats, _ := tournament.GetStats("b"); stats.Points != 1 {
+		t.Errorf("Expected live game not counted in team 'b' stats, got %v", stats)
+	}
+
+	played, err := tournament.EndLiveGame(live.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error ending live game: %v", err)
+	}
+	if played.TeamA != "b" || played.ScoreA != 2 || played.TeamB != "c" || played.ScoreB != 0 {
+		t.Errorf("Unexpected played game: %v", played)
+	}
+	if liveGames, _ := tournament.GetLiveGames(); len(liveGames) != 0 {
+		t.Errorf("Expected no live games, got %v", liveGames)
+	}
+	if _, err := tournament.EndLiveGame(live.ID); err != ErrLiveGameNotFound {
+		t.Errorf("Expected error %v, got %v", ErrLiveGameNotFound, err)
+	}
+
+	expectedTypes := []EventType{GameCreated, LiveGameStarted, LiveScoreUpdated, GameCreated, LiveGameEnded}
+	if len(published.events) != len(expectedTypes) {
+		t.Fatalf("Expected %v events, got %v", len(expectedTypes), published.events)
+	}
+	for i, eventType := range expectedTypes {
+		if published.events[i].Type != eventType {
+			t.Errorf("Expected %v event at position %v, got %v", eventType, i+1, published.events[i])
+		}
+	}
+}
+
+func TestCancelLiveGame(t *testing.T) {
+	live := NewLiveGames()
+	tournament := NewTournament(&GamesArray{}, WithLiveGames(live))
+	other := NewTournament(&GamesArray{}, WithLiveGames(live.ForCompetition(2)))
+
+	game, _ := tournament.StartLiveGame(Game{TeamA: "a", TeamB: "b"})
+	if liveGames, _ := other.GetLiveGames(); len(liveGames) != 0 {
+		t.Errorf("Expected no live games in another competition, got %v", liveGames)
+	}
+	if err := tournament.CancelLiveGame(game.ID); err != nil {
+		t.Fatalf("Unexpected error cancelling live game: %v", err)
+	}
+	if _, err := tournament.UpdateLiveGame(game.ID, Game{ScoreA: 1}); err != ErrLiveGameNotFound {
+		t.Errorf("Expected error %v, got %v", ErrLiveGameNotFound, err)
+	}
+	if allStats, _ := tournament.GetAllStats(); len(allStats) != 0 {
+		t.Errorf("Expected cancelled game not played, got %v", allStats)
+	}
+}
+
+func TestEndUndecidedLiveKnockoutGame(t *testing.T) {
+	tournament := NewTournament(&GamesArray{}, WithBrackets(&BracketsArray{}), WithLiveGames(NewLiveGames()))
+	tournament.CreateBracket("cup", []string{"a", "b"})
+
+	live, _ := tournament.StartLiveGame(Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 1})
+	if _, err := tournament.EndLiveGame(live.ID); err != ErrUndecidedKnockoutGame {
+		t.Errorf("Expected error %v, got %v", ErrUndecidedKnockoutGame, err)
+	}
+	if liveGames, _ := tournament.GetLiveGames(); len(liveGames) != 1 {
+		t.Errorf("Expected the undecided game still live, got %v", liveGames)
+	}
+}
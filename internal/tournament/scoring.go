@@ -0,0 +1,73 @@
+package tournament
+
+// ScoringRules define how many points a team gets for the result of a game.
+type ScoringRules struct {
+	Win  int
+	Draw int
+	Loss int
+	// BigWinMargin is the goal margin from which a win earns BigWinBonus
+	// points on top of Win. Zero disables the bonus.
+	BigWinMargin int
+	BigWinBonus  int
+	// ForfeitDeduction is taken from the points of a team losing by forfeit,
+	// on top of Loss. Awarded results never earn the big win bonus.
+	ForfeitDeduction int
+	// Overtime scores the games decided in extra time or by penalties with
+	// OvertimeWin and OvertimeLoss, and counts them as a win and a loss.
+	// Otherwise a game decided by penalties is a draw.
+	Overtime     bool
+	OvertimeWin  int
+	OvertimeLoss int
+}
+
+// DefaultScoringRules give 3 points for a win, 1 for a draw and 0 for a loss.
+var DefaultScoringRules = ScoringRules{Win: 3, Draw: 1, Loss: 0}
+
+// TwoPointScoringRules give 2 points for a win, 1 for a draw and 0 for a loss.
+var TwoPointScoringRules = ScoringRules{Win: 2, Draw: 1, Loss: 0}
+
+// OvertimeScoringRules give 3 points for a regulation win, 2 for a win and 1
+// for a loss in overtime or shootout, and 0 for a regulation loss.
+var OvertimeScoringRules = ScoringRules{Win: 3, Draw: 1, Loss: 0, Overtime: true, OvertimeWin: 2, OvertimeLoss: 1}
+
+// Points returns the points earned by team A and team B in the game.
+func (r ScoringRules) Points(game *Game) (pointsA, pointsB int) {
+	switch game.Outcome {
+	case DoubleForfeit:
+		return r.Loss - r.ForfeitDeduction, r.Loss - r.ForfeitDeduction
+	case Forfeit, Walkover:
+		loss := r.Loss
+		if game.Outcome == Forfeit {
+			loss -= r.ForfeitDeduction
+		}
+		if game.ScoreA > game.ScoreB {
+			return r.Win, loss
+		}
+		return loss, r.Win
+	}
+
+	if r.Overtime && game.DecidedIn != Regulation {
+		switch game.Winner() {
+		case game.TeamA:
+			return r.OvertimeWin, r.OvertimeLoss
+		case game.TeamB:
+			return r.OvertimeLoss, r.OvertimeWin
+		}
+	}
+
+	switch {
+	case game.ScoreA > game.ScoreB:
+		return r.winPoints(game.ScoreA - game.ScoreB), r.Loss
+	case game.ScoreA < game.ScoreB:
+		return r.Loss, r.winPoints(game.ScoreB - game.ScoreA)
+	default:
+		return r.Draw, r.Draw
+	}
+}
+
+func (r ScoringRules) winPoints(margin int) int {
+	if r.BigWinMargin > 0 && margin >= r.BigWinMargin {
+		return r.Win + r.BigWinBonus
+	}
+	return r.Win
+}
@@ -0,0 +1,99 @@
+package tournament
+
+import "testing"
+
+var scoringTestData = []struct {
+	testName string
+	rules    ScoringRules
+	game     Game
+	pointsA  int
+	pointsB  int
+}{
+	{"default win", DefaultScoringRules, Game{TeamA: "a", ScoreA: 2, TeamB: "b", ScoreB: 1}, 3, 0},
+	{"default loss", DefaultScoringRules, Game{TeamA: "a", ScoreA: 0, TeamB: "b", ScoreB: 1}, 0, 3},
+	{"default draw", DefaultScoringRules, Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 1}, 1, 1},
+	{"two points win", TwoPointScoringRules, Game{TeamA: "a", ScoreA: 2, TeamB: "b", ScoreB: 1}, 2, 0},
+	{"negative loss", ScoringRules{Win: 3, Draw: 1, Loss: -1}, Game{TeamA: "a", ScoreA: 2, TeamB: "b", ScoreB: 1}, 3, -1},
+	{"big win bonus", ScoringRules{Win: 3, Draw: 1, BigWinMargin: 3, BigWinBonus: 1}, Game{TeamA: "a", ScoreA: 0, TeamB: "b", ScoreB: 4}, 0, 4},
+	{"below big win margin", ScoringRules{Win: 3, Draw: 1, BigWinMargin: 3, BigWinBonus: 1}, Game{TeamA: "a", ScoreA: 2, TeamB: "b", ScoreB: 0}, 3, 0},
+	{"forfeit deduction", ScoringRules{Win: 3, Draw: 1, ForfeitDeduction: 1}, Game{TeamA: "a", ScoreA: 3, TeamB: "b", ScoreB: 0, Outcome: Forfeit}, 3, -1},
+	{"walkover without deduction", ScoringRules{Win: 3, Draw: 1, ForfeitDeduction: 1}, Game{TeamA: "a", ScoreA: 0, TeamB: "b", ScoreB: 3, Outcome: Walkover}, 0, 3},
+	{"double forfeit", ScoringRules{Win: 3, Draw: 1, ForfeitDeduction: 1}, Game{TeamA: "a", TeamB: "b", Outcome: DoubleForfeit}, -1, -1},
+	{"overtime win", OvertimeScoringRules, Game{TeamA: "a", ScoreA: 3, TeamB: "b", ScoreB: 2, DecidedIn: ExtraTime}, 2, 1},
+	{"shootout loss", OvertimeScoringRules, Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 1, DecidedIn: Penalties, PenaltiesA: 2, PenaltiesB: 4}, 1, 2},
+	{"regulation win with overtime rules", OvertimeScoringRules, Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 0}, 3, 0},
+	{"shootout draw without overtime rules", DefaultScoringRules, Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 1, DecidedIn: Penalties, PenaltiesA: 2, PenaltiesB: 4}, 1, 1},
+	{"awarded without big win bonus", ScoringRules{Win: 3, Draw: 1, BigWinMargin: 3, BigWinBonus: 1}, Game{TeamA: "a", ScoreA: 3, TeamB: "b", ScoreB: 0, Outcome: Forfeit}, 3, 0},
+}
+
+func TestScoringRulesPoints(t *testing.T) {
+	for _, testData := range scoringTestData {
+		pointsA, pointsB := testData.rules.Points(&testData.game)
+		if pointsA != testData.pointsA || pointsB != testData.pointsB {
+			t.Errorf("%v: expected points %v-%v, got %v-%v", testData.testName, testData.pointsA, testData.pointsB, pointsA, pointsB)
+		}
+	}
+}
+
+func TestTournamentScoringRules(t *testing.T) {
+	tournament := NewTournament(&GamesArray{}, WithScoringRules(TwoPointScoringRules))
+	tournament.Play(Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 0})
+	tournament.Play(Game{TeamA: "a", ScoreA: 2, TeamB: "c", ScoreB: 2})
+
+	allStats, _ := tournament.GetAllStats()
+	expectedPoints := map[string]int{"a": 3, "b": 0, "c": 1}
+	for _, s := range allStats {
+		if s.Points != expectedPoints[s.Team] {
+			t.Errorf("Team '%v' - expected %v points, got %v", s.Team, expectedPoints[s.Team], s.Points)
+		}
+	}
+
+	aStats, _ := tournament.GetStats("a")
+	if aStats.Points != 3 {
+		t.Errorf("Team 'a' stats - expected 3 points, got %v", aStats.Points)
+	}
+}
+
+func TestDoubleForfeitStats(t *testing.T) {
+	tournament := NewTournament(&GamesArray{})
+	tournament.Play(Game{TeamA: "a", TeamB: "b", Outcome: DoubleForfeit})
+
+	allStats, _ := tournament.GetAllStats()
+	for _, s := range allStats {
+		if s.Lost != 1 || s.Drawn != 0 {
+			t.Errorf("Team '%v' - expected a loss, got %v", s.Team, s)
+		}
+	}
+}
+
+func TestOvertimeStats(t *testing.T) {
+	tournament := NewTournament(&GamesArray{}, WithScoringRules(OvertimeScoringRules))
+	tournament.Play(Game{TeamA: "a", ScoreA: 2, TeamB: "b", ScoreB: 1})
+	tournament.Play(Game{TeamA: "b", ScoreA: 3, TeamB: "c", ScoreB: 2, DecidedIn: ExtraTime})
+	tournament.Play(Game{TeamA: "c", ScoreA: 1, TeamB: "a", ScoreB: 1, DecidedIn: Penalties, PenaltiesA: 3, PenaltiesB: 2})
+
+	expectedStats := map[string]Stats{
+		"a": {Team: "a", Played: 2, Won: 1, Lost: 1, GoalsFor: 3, GoalsAgainst: 2, GoalDifference: 1, Points: 4, RegulationWon: 1, OvertimeLost: 1},
+		"b": {Team: "b", Played: 2, Won: 1, Lost: 1, GoalsFor: 4, GoalsAgainst: 4, Points: 2, OvertimeWon: 1},
+		"c": {Team: "c", Played: 2, Won: 1, Lost: 1, GoalsFor: 3, GoalsAgainst: 4, GoalDifference: -1, Points: 3, OvertimeWon: 1, OvertimeLost: 1},
+	}
+	allStats, _ := tournament.GetAllStats()
+	for _, s := range allStats {
+		if s != expectedStats[s.Team] {
+			t.Errorf("Team '%v' - expected %v, got %v", s.Team, expectedStats[s.Team], s)
+		}
+	}
+}
+
+func TestNoOvertimeColumnsWithoutOvertimeScoring(t *testing.T) {
+	tournament := NewTournament(&GamesArray{})
+	tournament.Play(Game{TeamA: "a", ScoreA: 3, TeamB: "b", ScoreB: 2, DecidedIn: ExtraTime})
+	tournament.Play(Game{TeamA: "b", ScoreA: 1, TeamB: "c", ScoreB: 1, DecidedIn: Penalties, PenaltiesA: 5, PenaltiesB: 4})
+
+	allStats, _ := tournament.GetAllStats()
+	for _, s := range allStats {
+		if s.OvertimeWon != 0 || s.OvertimeLost != 0 {
+			t.Errorf("Team '%v' - expected no overtime games without overtime scoring, got %v", s.Team, s)
+		}
+	}
+}
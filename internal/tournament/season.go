@@ -0,0 +1,186 @@
+package tournament
+
+import (
+	"errors"
+)
+
+var ErrSeasonClosed = errors.New("Season is closed")
+var ErrSeasonNotClosed = errors.New("Season is not closed")
+var ErrNoDivisions = errors.New("No divisions to roll over")
+var ErrInvalidPromotions = errors.New("Invalid number of promotions and relegations")
+
+// Rollover opens the next season of competitions whose seasons are closed.
+type Rollover struct {
+	// Season of the new competitions
+	Season string
+	// Divisions are the IDs of the closed competitions, from the top division
+	Divisions []int
+	// Promotions is the number of teams going up from every division without
+	// zones to the one above, and the number of teams going down the other way
+	Promotions int
+	// PlayoffMovers are the teams from playoff places which won promotion or
+	// lost relegation in the playoffs
+	PlayoffMovers []string
+}
+
+// CloseSeason freezes the current standings of the competition. The
+// competition no longer accepts games and its standings stay the same.
+func (o *Organizer) CloseSeason(competitionID int) ([]Stats, error) {
+	competition, err := o.competitions.FindByID(competitionID)
+	if err != nil {
+		return nil, err
+	}
+	if competition.Closed {
+		return nil, ErrSeasonClosed
+	}
+
+	standings, err := o.newTournament(competition).GetAllStats()
+	if err != nil {
+		return nil, err
+	}
+	if err := o.competitions.Close(competitionID, standings); err != nil {
+		return nil, err
+	}
+	return standings, nil
+}
+
+// RolloverSeason creates a competition for the new season of every division,
+// with the same name, format, scoring rules and zones. The teams stay in their
+// division except for those finishing in the promotion and relegation zones,
+// and the playoff movers.
+func (o *Organizer) RolloverSeason(rollover Rollover) ([]Competition, error) {
+	if len(rollover.Divisions) == 0 {
+		return nil, ErrNoDivisions
+	}
+	if rollover.Promotions < 0 {
+		return nil, ErrInvalidPromotions
+	}
+
+	movers := make(map[string]bool, len(rollover.PlayoffMovers))
+	for _, team := range rollover.PlayoffMovers {
+		movers[team] = true
+	}
+
+	divisions := make([]*Competition, 0, len(rollover.Divisions))
+	stayers := make([][]string, 0, len(rollover.Divisions))
+	up := make([][]string, 0, len(rollover.Divisions))
+	down := make([][]string, 0, len(rollover.Divisions))
+	for i, id := range rollover.Divisions {
+		competition, err := o.competitions.FindByID(id)
+		if err != nil {
+			return nil, err
+		}
+		if !competition.Closed {
+			return nil, ErrSeasonNotClosed
+		}
+		standings, err := o.competitions.FindStandings(id)
+		if err != nil {
+			return nil, err
+		}
+		teams := finalOrder(standings, competition.Teams)
+
+		zones := competition.Zones
+		if zones.IsZero() {
+			if len(rollover.Divisions) > 1 && len(teams) < 2*rollover.Promotions {
+				return nil, ErrInvalidPromotions
+			}
+			zones = Zones{Promotion: rollover.Promotions, Relegation: rollover.Promotions}
+		}
+		moves := movements(teams, zones)
+
+		leaving := map[string]bool{}
+		var divisionUp, divisionDown []string
+		if i > 0 {
+			divisionUp = append(moves.Promoted, playoffMovers(moves.PromotionPlayoff, movers)...)
+		}
+		if i < len(rollover.Divisions)-1 {
+			divisionDown = append(moves.Relegated, playoffMovers(moves.RelegationPlayoff, movers)...)
+		}
+		for _, team := range append(divisionUp, divisionDown...) {
+			leaving[team] = true
+			delete(movers, team)
+		}
+
+		divisionStayers := []string{}
+		for _, team := range teams {
+			if !leaving[team] {
+				divisionStayers = append(divisionStayers, team)
+			}
+		}
+
+		divisions = append(divisions, competition)
+		stayers = append(stayers, divisionStayers)
+		up = append(up, divisionUp)
+		down = append(down, divisionDown)
+	}
+	if len(movers) > 0 {
+		return nil, ErrNotInPlayoffZone
+	}
+
+	next := make([][]string, len(divisions))
+	for i := range divisions {
+		if i > 0 {
+			next[i] = append(next[i], down[i-1]...)
+		}
+		next[i] = append(next[i], stayers[i]...)
+		if i < len(divisions)-1 {
+			next[i] = append(next[i], up[i+1]...)
+		}
+	}
+
+	allTeams := []string{}
+	for _, divisionTeams := range next {
+		if err := validateTeams(divisionTeams); err != nil {
+			return nil, err
+		}
+		allTeams = append(allTeams, divisionTeams...)
+	}
+	if err := validateTeams(allTeams); err != nil {
+		return nil, err
+	}
+
+	result := make([]Competition, 0, len(divisions))
+	for i, division := range divisions {
+		competition := Competition{
+			Name:    division.Name,
+			Season:  rollover.Season,
+			Format:  division.Format,
+			Scoring: division.Scoring,
+			Zones:   division.Zones,
+			Teams:   next[i],
+		}
+		if err := o.competitions.Save(&competition); err != nil {
+			return nil, err
+		}
+		result = append(result, competition)
+	}
+	return result, nil
+}
+
+// finalOrder lists the teams by their final standings, followed by the entered
+// teams which have not played.
+func finalOrder(standings []Stats, entered []string) []string {
+	teams := teamNames(standings)
+	ranked := make(map[string]bool, len(teams))
+	for _, team := range teams {
+		ranked[team] = true
+	}
+	for _, team := range entered {
+		if !ranked[team] {
+			teams = append(teams, team)
+		}
+	}
+	return teams
+}
+
+// playoffMovers returns the teams from the playoff places which move to
+// another division.
+func playoffMovers(playoffTeams []string, movers map[string]bool) []string {
+	result := []string{}
+	for _, team := range playoffTeams {
+		if movers[team] {
+			result = append(result, team)
+		}
+	}
+	return result
+}
@@ -0,0 +1,85 @@
+package tournament
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCloseSeason(t *testing.T) {
+	organizer := newTestOrganizer()
+	league, _ := organizer.CreateCompetition(Competition{Name: "league", Season: "2026", Format: LeagueFormat, Scoring: DefaultScoringRules})
+
+	leagueTournament, _ := organizer.Tournament(league.ID)
+	leagueTournament.Play(Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 0})
+
+	standings, err := organizer.CloseSeason(league.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error closing season: %v", err)
+	}
+	if !reflect.DeepEqual(teamNames(standings), []string{"a", "b"}) {
+		t.Errorf("Expected final standings [a b], got %v", teamNames(standings))
+	}
+
+	leagueTournament, _ = organizer.Tournament(league.ID)
+	if _, err := leagueTournament.Play(Game{TeamA: "b", ScoreA: 3, TeamB: "a", ScoreB: 0}); err != ErrSeasonClosed {
+		t.Errorf("Expected ErrSeasonClosed playing in closed season, got %v", err)
+	}
+	if _, err := organizer.CloseSeason(league.ID); err != ErrSeasonClosed {
+		t.Errorf("Expected ErrSeasonClosed closing season twice, got %v", err)
+	}
+
+	allStats, _ := leagueTournament.GetAllStats()
+	if !reflect.DeepEqual(allStats, standings) {
+		t.Errorf("Expected frozen standings %v, got %v", standings, allStats)
+	}
+	if stats, _ := leagueTournament.GetStats("b"); stats.Lost != 1 {
+		t.Errorf("Expected frozen stats of b with 1 loss, got %v", stats)
+	}
+}
+
+func TestRolloverSeason(t *testing.T) {
+	organizer := newTestOrganizer()
+	first, _ := organizer.CreateCompetition(Competition{Name: "first", Season: "2026", Format: LeagueFormat, Scoring: DefaultScoringRules})
+	second, _ := organizer.CreateCompetition(Competition{Name: "second", Season: "2026", Format: LeagueFormat, Scoring: TwoPointScoringRules,
+		Teams: []string{"e", "f", "g", "h", "i"}})
+
+	firstTournament, _ := organizer.Tournament(first.ID)
+	firstTournament.Play(Game{TeamA: "a", ScoreA: 3, TeamB: "b", ScoreB: 0})
+	firstTournament.Play(Game{TeamA: "c", ScoreA: 2, TeamB: "d", ScoreB: 0})
+	secondTournament, _ := organizer.Tournament(second.ID)
+	secondTournament.Play(Game{TeamA: "e", ScoreA: 0, TeamB: "f", ScoreB: 1})
+	secondTournament.Play(Game{TeamA: "g", ScoreA: 0, TeamB: "h", ScoreB: 2})
+
+	rollover := Rollover{Season: "2027", Divisions: []int{first.ID, second.ID}, Promotions: 1}
+	if _, err := organizer.RolloverSeason(rollover); err != ErrSeasonNotClosed {
+		t.Errorf("Expected ErrSeasonNotClosed, got %v", err)
+	}
+
+	organizer.CloseSeason(first.ID)
+	organizer.CloseSeason(second.ID)
+
+	next, err := organizer.RolloverSeason(rollover)
+	if err != nil {
+		t.Fatalf("Unexpected error rolling over season: %v", err)
+	}
+	if len(next) != 2 {
+		t.Fatalf("Expected 2 divisions, got %v", next)
+	}
+	if next[0].Name != "first" || next[0].Season != "2027" || next[0].Closed {
+		t.Errorf("Expected open 2027 season of first division, got %v", next[0])
+	}
+	if next[1].Scoring != TwoPointScoringRules {
+		t.Errorf("Expected scoring rules of second division to be kept, got %v", next[1].Scoring)
+	}
+	if !reflect.DeepEqual(next[0].Teams, []string{"a", "c", "d", "h"}) {
+		t.Errorf("Expected first division teams [a c d h], got %v", next[0].Teams)
+	}
+	if !reflect.DeepEqual(next[1].Teams, []string{"b", "f", "e", "g", "i"}) {
+		t.Errorf("Expected second division teams [b f e g i], got %v", next[1].Teams)
+	}
+
+	rollover.Promotions = 3
+	if _, err := organizer.RolloverSeason(rollover); err != ErrInvalidPromotions {
+		t.Errorf("Expected ErrInvalidPromotions, got %v", err)
+	}
+}
@@ -0,0 +1,204 @@
+package tournament
+
+import (
+	"errors"
+	"sort"
+)
+
+var ErrRoundNotComplete = errors.New("Previous round has games not played yet")
+var ErrNoPairings = errors.New("Teams cannot be paired without repeating a game")
+
+// SwissPairings returns the fixtures of the round following the previous
+// fixtures. Standings must list every team from the highest ranked. Teams are
+// paired with the closest ranked team on the same points they have not played
+// yet, and when no such pairing exists, with teams on fewer points. The team
+// that has hosted fewer games plays at home. With an odd number of teams the
+// lowest ranked team that has not had a bye yet gets one.
+func SwissPairings(standings []Stats, previous []Fixture) ([]Fixture, error) {
+	round := 1
+	played := map[[2]string]bool{}
+	hadBye := map[string]bool{}
+	balance := map[string]int{}
+	for _, f := range previous {
+		if f.Round >= round {
+			round = f.Round + 1
+		}
+		if f.IsBye() {
+			hadBye[f.HomeTeam] = true
+			continue
+		}
+		played[pairKey(f.HomeTeam, f.AwayTeam)] = true
+		balance[f.HomeTeam]++
+		balance[f.AwayTeam]--
+	}
+
+	ranked := append([]Stats(nil), standings...)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Points > ranked[j].Points
+	})
+
+	byeCandidates := []int{-1}
+	if len(ranked)%2 == 1 {
+		byeCandidates = byeCandidates[:0]
+		for i := len(ranked) - 1; i >= 0; i-- {
+			if !hadBye[ranked[i].Team] {
+				byeCandidates = append(byeCandidates, i)
+			}
+		}
+		// everybody had a bye already, so the next round starts over
+		for i := len(ranked) - 1; len(byeCandidates) == 0 && i >= 0; i-- {
+			byeCandidates = append(byeCandidates, i)
+		}
+	}
+
+	for _, bye := range byeCandidates {
+		teams := make([]string, 0, len(ranked))
+		for i, s := range ranked {
+			if i != bye {
+				teams = append(teams, s.Team)
+			}
+		}
+
+		pairs, ok := pairTeams(teams, played)
+		if !ok {
+			continue
+		}
+
+		fixtures := make([]Fixture, 0, len(pairs)+1)
+		for _, pair := range pairs {
+			home, away := pair[0], pair[1]
+			if balance[away] < balance[home] {
+				home, away = away, home
+			}
+			fixtures = append(fixtures, Fixture{Round: round, HomeTeam: home, AwayTeam: away})
+		}
+		if bye >= 0 {
+			fixtures = append(fixtures, Fixture{Round: round, HomeTeam: ranked[bye].Team})
+		}
+		return fixtures, nil
+	}
+
+	return nil, ErrNoPairings
+}
+
+// pairTeams pairs the first team with the next one it has not played yet and
+// continues with the remaining teams, backtracking when they cannot be paired.
+func pairTeams(teams []string, played map[[2]string]bool) ([][2]string, bool) {
+	if len(teams) == 0 {
+		return [][2]string{}, true
+	}
+
+	for i := 1; i < len(teams); i++ {
+		if played[pairKey(teams[0], teams[i])] {
+			continue
+		}
+
+		rest := make([]string, 0, len(teams)-2)
+		rest = append(rest, teams[1:i]...)
+		rest = append(rest, teams[i+1:]...)
+		if pairs, ok := pairTeams(rest, played); ok {
+			return append([][2]string{{teams[0], teams[i]}}, pairs...), true
+		}
+	}
+	return nil, false
+}
+
+func pairKey(teamA, teamB string) [2]string {
+	if teamA > teamB {
+		return [2]string{teamB, teamA}
+	}
+	return [2]string{teamA, teamB}
+}
+
+// PairSwissRound creates and stores the next round of a Swiss-system
+// tournament. The teams, listed from the top seed, are needed for the first
+// round only. Later rounds can be paired once all games of the previous round
+// are recorded. A bye counts as a win in the standings used for pairing.
+func (t *Tournament) PairSwissRound(teams []string) ([]Fixture, error) {
+	if t.fixtures == nil {
+		return nil, ErrFixturesNotConfigured
+	}
+	if t.archive != nil {
+		return nil, ErrSeasonClosed
+	}
+
+	previous, err := t.fixtures.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var standings []Stats
+	if len(previous) == 0 {
+		if err := validateTeams(teams); err != nil {
+			return nil, err
+		}
+		for _, team := range teams {
+			standings = append(standings, Stats{Team: team})
+		}
+	} else {
+		standings, err = t.swissStandings(previous)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	fixtures, err := SwissPairings(standings, previous)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.fixtures.Save(fixtures); err != nil {
+		return nil, err
+	}
+	return fixtures, nil
+}
+
+func (t *Tournament) swissStandings(previous []Fixture) ([]Stats, error) {
+	allGames, err := t.games.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	playedGames := map[[2]string]int{}
+	for _, game := range allGames {
+		playedGames[pairKey(game.TeamA, game.TeamB)]++
+	}
+
+	byes := map[string]int{}
+	teams := []string{}
+	seen := map[string]bool{}
+	for _, f := range previous {
+		for _, team := range []string{f.HomeTeam, f.AwayTeam} {
+			if team != "" && !seen[team] {
+				seen[team] = true
+				teams = append(teams, team)
+			}
+		}
+		if f.IsBye() {
+			byes[f.HomeTeam]++
+		} else if playedGames[pairKey(f.HomeTeam, f.AwayTeam)] == 0 {
+			return nil, ErrRoundNotComplete
+		}
+	}
+
+	allStats, err := t.GetAllStats()
+	if err != nil {
+		return nil, err
+	}
+
+	standings := make([]Stats, 0, len(teams))
+	for _, s := range allStats {
+		if seen[s.Team] {
+			standings = append(standings, s)
+			delete(seen, s.Team)
+		}
+	}
+	for _, team := range teams {
+		if seen[team] {
+			standings = append(standings, Stats{Team: team})
+		}
+	}
+	for i := range standings {
+		standings[i].Points += byes[standings[i].Team] * t.rules.Win
+	}
+	return standings, nil
+}
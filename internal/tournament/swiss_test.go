@@ -0,0 +1,141 @@
+package tournament
+
+import (
+	"reflect"
+	"testing"
+)
+
+var swissTestData = []struct {
+	testName  string
+	standings []string
+	points    map[string]int
+	previous  []Fixture
+	pairings  []Fixture
+}{
+	{
+		testName:  "first round",
+		standings: []string{"a", "b", "c", "d"},
+		pairings: []Fixture{
+			{Round: 1, HomeTeam: "a", AwayTeam: "b"},
+			{Round: 1, HomeTeam: "c", AwayTeam: "d"},
+		},
+	},
+	{
+		testName:  "equal points paired together",
+		standings: []string{"a", "c", "b", "d"},
+		points:    map[string]int{"a": 3, "c": 3},
+		previous: []Fixture{
+			{Round: 1, HomeTeam: "a", AwayTeam: "b"},
+			{Round: 1, HomeTeam: "d", AwayTeam: "c"},
+		},
+		pairings: []Fixture{
+			{Round: 2, HomeTeam: "c", AwayTeam: "a"},
+			{Round: 2, HomeTeam: "b", AwayTeam: "d"},
+		},
+	},
+	{
+		testName:  "pairing not repeated",
+		standings: []string{"a", "b", "c", "d"},
+		points:    map[string]int{"a": 4, "b": 4, "c": 1, "d": 1},
+		previous: []Fixture{
+			{Round: 1, HomeTeam: "a", AwayTeam: "c"},
+			{Round: 1, HomeTeam: "b", AwayTeam: "d"},
+			{Round: 2, HomeTeam: "d", AwayTeam: "a"},
+			{Round: 2, HomeTeam: "c", AwayTeam: "b"},
+		},
+		pairings: []Fixture{
+			{Round: 3, HomeTeam: "a", AwayTeam: "b"},
+			{Round: 3, HomeTeam: "c", AwayTeam: "d"},
+		},
+	},
+	{
+		testName:  "backtracking when lower teams already met",
+		standings: []string{"a", "b", "c", "d"},
+		previous: []Fixture{
+			{Round: 1, HomeTeam: "c", AwayTeam: "d"},
+		},
+		pairings: []Fixture{
+			{Round: 2, HomeTeam: "a", AwayTeam: "c"},
+			{Round: 2, HomeTeam: "d", AwayTeam: "b"},
+		},
+	},
+	{
+		testName:  "bye for lowest ranked team without one",
+		standings: []string{"a", "b", "c", "d", "e"},
+		points:    map[string]int{"a": 3, "b": 3},
+		previous: []Fixture{
+			{Round: 1, HomeTeam: "a", AwayTeam: "c"},
+			{Round: 1, HomeTeam: "b", AwayTeam: "d"},
+			{Round: 1, HomeTeam: "e"},
+		},
+		pairings: []Fixture{
+			{Round: 2, HomeTeam: "a", AwayTeam: "b"},
+			{Round: 2, HomeTeam: "c", AwayTeam: "e"},
+			{Round: 2, HomeTeam: "d"},
+		},
+	},
+}
+
+func TestSwissPairings(t *testing.T) {
+	for _, testData := range swissTestData {
+		standings := make([]Stats, 0, len(testData.standings))
+		for _, team := range testData.standings {
+			standings = append(standings, Stats{Team: team, Points: testData.points[team]})
+		}
+
+		pairings, err := SwissPairings(standings, testData.previous)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", testData.testName, err)
+		}
+		if !reflect.DeepEqual(pairings, testData.pairings) {
+			t.Errorf("%v: expected pairings %v, got %v", testData.testName, testData.pairings, pairings)
+		}
+	}
+}
+
+func TestSwissPairingsExhausted(t *testing.T) {
+	standings := []Stats{{Team: "a"}, {Team: "b"}}
+	previous := []Fixture{{Round: 1, HomeTeam: "a", AwayTeam: "b"}}
+
+	if _, err := SwissPairings(standings, previous); err != ErrNoPairings {
+		t.Errorf("Expected ErrNoPairings, got %v", err)
+	}
+}
+
+func TestPairSwissRound(t *testing.T) {
+	tournament := NewTournament(&GamesArray{}, WithFixtures(&FixturesArray{}))
+
+	if _, err := tournament.PairSwissRound(nil); err != ErrNotEnoughTeams {
+		t.Errorf("Expected ErrNotEnoughTeams for first round without teams, got %v", err)
+	}
+
+	first, err := tournament.PairSwissRound([]string{"a", "b", "c"})
+	if err != nil {
+		t.Fatalf("Unexpected error pairing first round: %v", err)
+	}
+	expected := []Fixture{
+		{ID: 1, Round: 1, HomeTeam: "a", AwayTeam: "b"},
+		{ID: 2, Round: 1, HomeTeam: "c"},
+	}
+	if !reflect.DeepEqual(first, expected) {
+		t.Errorf("Expected first round %v, got %v", expected, first)
+	}
+
+	if _, err := tournament.PairSwissRound(nil); err != ErrRoundNotComplete {
+		t.Errorf("Expected ErrRoundNotComplete, got %v", err)
+	}
+
+	tournament.Play(Game{TeamA: "a", ScoreA: 0, TeamB: "b", ScoreB: 1})
+
+	second, err := tournament.PairSwissRound(nil)
+	if err != nil {
+		t.Fatalf("Unexpected error pairing second round: %v", err)
+	}
+	expected = []Fixture{
+		{ID: 3, Round: 2, HomeTeam: "b", AwayTeam: "c"},
+		{ID: 4, Round: 2, HomeTeam: "a"},
+	}
+	if !reflect.DeepEqual(second, expected) {
+		t.Errorf("Expected second round %v, got %v", expected, second)
+	}
+}
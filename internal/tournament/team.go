@@ -0,0 +1,252 @@
+package tournament
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxShortCodeLength is the longest short code of a team, like "ARS".
+const MaxShortCodeLength = 5
+
+var ErrTeamExists = errors.New("Team name, short code or alias already used")
+var ErrTeamInUse = errors.New("Team has recorded games")
+var ErrMergeSameTeam = errors.New("Team cannot be merged into itself")
+var ErrTeamsPlayedEachOther = errors.New("Teams played each other and cannot be merged")
+
+// Team of the registry. Games name the team by its display name, or by one
+// of its aliases which is then replaced by the display name.
+type Team struct {
+	ID        int
+	Name      string
+	ShortCode string
+	Aliases   []string
+}
+
+// TeamAction is the kind of change of a team recorded in its history.
+type TeamAction string
+
+const (
+	RenameAction TeamAction = "rename"
+	MergeAction  TeamAction = "merge"
+)
+
+// TeamChange records a rename of the team, or a merge of another team into
+// it. From is the name before the change, or the name of the merged team.
+type TeamChange struct {
+	ID        int
+	TeamID    int
+	Action    TeamAction
+	From      string
+	To        string
+	Principal string
+	RequestID string
+	At        time.Time
+}
+
+type Teams interface {
+	Save(team *Team) error
+	// Update changes the team keeping its name.
+	Update(team *Team) error
+	// Rename changes the team along with its name wherever it is used, and
+	// records the change, in a single transaction.
+	Rename(team *Team, change *TeamChange) error
+	// Merge moves the games of the team into the other team, replaces its
+	// name wherever it is used, records the change and removes the team, in
+	// a single transaction.
+	Merge(from, into *Team, change *TeamChange) error
+	FindChanges(teamID int) ([]TeamChange, error)
+	Delete(id int) error
+	FindByID(id int) (*Team, error)
+	// FindByName finds the team by its name or alias, ignoring case.
+	FindByName(name string) (*Team, error)
+	FindAll() ([]Team, error)
+}
+
+// TeamRegistry manages the teams shared by all competitions.
+type TeamRegistry struct {
+	teams Teams
+	actor Actor
+}
+
+func NewTeamRegistry(teams Teams) *TeamRegistry {
+	return &TeamRegistry{teams: teams}
+}
+
+// WithTeamRegistry sets the registry used to spell the team names of games
+// like the registered teams.
+func WithTeamRegistry(teams Teams) Option {
+	return func(t *Tournament) {
+		t.registry = teams
+	}
+}
+
+// As returns the registry recording the renames and merges it makes as made
+// by the actor.
+func (r *TeamRegistry) As(actor Actor) *TeamRegistry {
+	acting := *r
+	acting.actor = actor
+	return &acting
+}
+
+func (r *TeamRegistry) CreateTeam(team Team) (*Team, error) {
+	team.ID = 0
+	if err := r.validate(&team); err != nil {
+		return nil, err
+	}
+	if err := r.teams.Save(&team); err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+func (r *TeamRegistry) GetTeam(id int) (*Team, error) {
+	return r.teams.FindByID(id)
+}
+
+func (r *TeamRegistry) GetTeams() ([]Team, error) {
+	return r.teams.FindAll()
+}
+
+// UpdateTeam changes the team. A new name replaces the old one in the games,
+// fixtures, brackets, group stages and standings of the team.
+func (r *TeamRegistry) UpdateTeam(team Team) (*Team, error) {
+	recorded, err := r.teams.FindByID(team.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.validate(&team); err != nil {
+		return nil, err
+	}
+
+	if team.Name == recorded.Name {
+		err = r.teams.Update(&team)
+	} else {
+		err = r.teams.Rename(&team, r.change(RenameAction, &team, recorded.Name))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+// RenameTeam changes the name of the team, keeping the old name as its alias.
+func (r *TeamRegistry) RenameTeam(id int, name string) (*Team, error) {
+	team, err := r.teams.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	name = NormalizeTeamName(name)
+	aliases := []string{}
+	if !strings.EqualFold(team.Name, name) {
+		aliases = append(aliases, team.Name)
+	}
+	for _, alias := range team.Aliases {
+		if !strings.EqualFold(alias, name) {
+			aliases = append(aliases, alias)
+		}
+	}
+	team.Name, team.Aliases = name, aliases
+	return r.UpdateTeam(*team)
+}
+
+// MergeTeams merges the team created by mistake into the other team, which
+// gets its games and keeps its names as aliases. Teams that played each other
+// cannot be merged.
+func (r *TeamRegistry) MergeTeams(fromID, intoID int) (*Team, error) {
+	if fromID == intoID {
+		return nil, ErrMergeSameTeam
+	}
+	from, err := r.teams.FindByID(fromID)
+	if err != nil {
+		return nil, err
+	}
+	into, err := r.teams.FindByID(intoID)
+	if err != nil {
+		return nil, err
+	}
+
+	into.Aliases = append(append(into.Aliases, from.Name), from.Aliases...)
+	if err := r.teams.Merge(from, into, r.change(MergeAction, into, from.Name)); err != nil {
+		return nil, err
+	}
+	return into, nil
+}
+
+// TeamHistory returns the renames of the team and the merges into it, the
+// oldest first.
+func (r *TeamRegistry) TeamHistory(id int) ([]TeamChange, error) {
+	if _, err := r.teams.FindByID(id); err != nil {
+		return nil, err
+	}
+	return r.teams.FindChanges(id)
+}
+
+func (r *TeamRegistry) change(action TeamAction, team *Team, from string) *TeamChange {
+	return &TeamChange{
+		TeamID:    team.ID,
+		Action:    action,
+		From:      from,
+		To:        team.Name,
+		Principal: r.actor.Principal,
+		RequestID: r.actor.RequestID,
+	}
+}
+
+// DeleteTeam removes the team, unless it has recorded games.
+func (r *TeamRegistry) DeleteTeam(id int) error {
+	return r.teams.Delete(id)
+}
+
+// validate normalizes the names of the team and checks that none of them is
+// used by another team.
+func (r *TeamRegistry) validate(team *Team) error {
+	verr := &ValidationError{}
+	team.Name = validateTeamName(verr, "name", team.Name)
+	team.ShortCode = strings.ToUpper(strings.TrimSpace(team.ShortCode))
+	if utf8.RuneCountInString(team.ShortCode) > MaxShortCodeLength {
+		verr.add("shortCode", "must be at most %d characters", MaxShortCodeLength)
+	}
+	team.Aliases = append([]string(nil), team.Aliases...)
+	for i := range team.Aliases {
+		team.Aliases[i] = validateTeamName(verr, "aliases", team.Aliases[i])
+	}
+	if len(verr.Errors) > 0 {
+		return verr
+	}
+
+	seen := map[string]bool{}
+	for _, name := range append([]string{team.Name}, team.Aliases...) {
+		if seen[strings.ToLower(name)] {
+			return ErrTeamExists
+		}
+		seen[strings.ToLower(name)] = true
+
+		other, err := r.teams.FindByName(name)
+		if err == ErrTeamNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if other.ID != team.ID {
+			return ErrTeamExists
+		}
+	}
+	return nil
+}
+
+// validateTeamName returns the normalized name, adding a field error when it
+// is empty or too long.
+func validateTeamName(verr *ValidationError, field, name string) string {
+	name = NormalizeTeamName(name)
+	switch {
+	case name == "":
+		verr.add(field, "must not be empty")
+	case utf8.RuneCountInString(name) > MaxTeamNameLength:
+		verr.add(field, "must be at most %d characters", MaxTeamNameLength)
+	}
+	return name
+}
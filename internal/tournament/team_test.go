@@ -0,0 +1,233 @@
+package tournament
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type TeamsArray struct {
+	teams   []Team
+	changes []TeamChange
+	lastID  int
+}
+
+func (ta *TeamsArray) Save(team *Team) error {
+	ta.lastID++
+	team.ID = ta.lastID
+	ta.teams = append(ta.teams, copyTeam(team))
+	return nil
+}
+
+func (ta *TeamsArray) Update(team *Team) error {
+	for i := range ta.teams {
+		if ta.teams[i].ID == team.ID {
+			ta.teams[i] = copyTeam(team)
+			return nil
+		}
+	}
+	return ErrTeamNotFound
+}
+
+func (ta *TeamsArray) Rename(team *Team, change *TeamChange) error {
+	if err := ta.Update(team); err != nil {
+		return err
+	}
+	return ta.record(change)
+}
+
+func (ta *TeamsArray) Merge(from, into *Team, change *TeamChange) error {
+	if err := ta.Delete(from.ID); err != nil {
+		return err
+	}
+	if err := ta.Update(into); err != nil {
+		return err
+	}
+	return ta.record(change)
+}
+
+func (ta *TeamsArray) record(change *TeamChange) error {
+	change.ID = len(ta.changes) + 1
+	change.At = time.Now()
+	ta.changes = append(ta.changes, *change)
+	return nil
+}
+
+func (ta *TeamsArray) FindChanges(teamID int) ([]TeamChange, error) {
+	changes := []TeamChange{}
+	for _, change := range ta.changes {
+		if change.TeamID == teamID {
+			changes = append(changes, change)
+		}
+	}
+	return changes, nil
+}
+
+func (ta *TeamsArray) Delete(id int) error {
+	for i := range ta.teams {
+		if ta.teams[i].ID == id {
+			ta.teams = append(ta.teams[:i], ta.teams[i+1:]...)
+			return nil
+		}
+	}
+	return ErrTeamNotFound
+}
+
+func (ta *TeamsArray) FindByID(id int) (*Team, error) {
+	for _, team := range ta.teams {
+		if team.ID == id {
+			team := copyTeam(&team)
+			return &team, nil
+		}
+	}
+	return nil, ErrTeamNotFound
+}
+
+func (ta *TeamsArray) FindByName(name string) (*Team, error) {
+	for _, team := range ta.teams {
+		for _, n := range append([]string{team.Name}, team.Aliases...) {
+			if strings.EqualFold(n, name) {
+				team := copyTeam(&team)
+				return &team, nil
+			}
+		}
+	}
+	return nil, ErrTeamNotFound
+}
+
+func (ta *TeamsArray) FindAll() ([]Team, error) {
+	return append([]Team{}, ta.teams...), nil
+}
+
+func copyTeam(team *Team) Team {
+	result := *team
+	result.Aliases = append([]string(nil), team.Aliases...)
+	return result
+}
+
+func TestCreateTeam(t *testing.T) {
+	registry := NewTeamRegistry(&TeamsArray{})
+
+	team, err := registry.CreateTeam(Team{Name: " Lions ", ShortCode: "lio", Aliases: []string{"LIONS  FC"}})
+	if err != nil {
+		t.Fatalf("Unexpected error creating team: %v", err)
+	}
+	expected := &Team{ID: 1, Name: "Lions", ShortCode: "LIO", Aliases: []string{"LIONS FC"}}
+	if !reflect.DeepEqual(team, expected) {
+		t.Errorf("Expected %v, got %v", expected, team)
+	}
+
+	if _, err := registry.CreateTeam(Team{Name: "lions fc"}); err != ErrTeamExists {
+		t.Errorf("Expected ErrTeamExists for name used as alias, got %v", err)
+	}
+	if _, err := registry.CreateTeam(Team{Name: "Tigers", Aliases: []string{"Tigers"}}); err != ErrTeamExists {
+		t.Errorf("Expected ErrTeamExists for alias same as name, got %v", err)
+	}
+
+	_, err = registry.CreateTeam(Team{Name: "", ShortCode: "TOOLONG", Aliases: []string{" "}})
+	expectedErrors := []FieldError{
+		{Field: "name", Message: "must not be empty"},
+		{Field: "shortCode", Message: "must be at most 5 characters"},
+		{Field: "aliases", Message: "must not be empty"},
+	}
+	if verr, ok := err.(*ValidationError); !ok || !reflect.DeepEqual(verr.Errors, expectedErrors) {
+		t.Errorf("Expected field errors %v, got %v", expectedErrors, err)
+	}
+}
+
+func TestUpdateTeam(t *testing.T) {
+	registry := NewTeamRegistry(&TeamsArray{})
+	lions, _ := registry.CreateTeam(Team{Name: "Lions", Aliases: []string{"Lions FC"}})
+	registry.CreateTeam(Team{Name: "Tigers"})
+
+	lions.Name = "Lions FC"
+	lions.Aliases = []string{"Lions"}
+	if _, err := registry.UpdateTeam(*lions); err != nil {
+		t.Errorf("Unexpected error swapping name and alias: %v", err)
+	}
+
+	lions.Aliases = []string{"tigers"}
+	if _, err := registry.UpdateTeam(*lions); err != ErrTeamExists {
+		t.Errorf("Expected ErrTeamExists for alias of another team, got %v", err)
+	}
+
+	if _, err := registry.UpdateTeam(Team{ID: 10, Name: "Bears"}); err != ErrTeamNotFound {
+		t.Errorf("Expected ErrTeamNotFound, got %v", err)
+	}
+}
+
+func TestGamesUseRegisteredNames(t *testing.T) {
+	teams := &TeamsArray{}
+	NewTeamRegistry(teams).CreateTeam(Team{Name: "Lions", Aliases: []string{"Lions FC"}})
+	tournament := NewTournament(&GamesArray{}, WithTeamRegistry(teams))
+
+	tournament.Play(Game{TeamA: "lions ", ScoreA: 1, TeamB: "Tigers", ScoreB: 0})
+	game, err := tournament.Play(Game{TeamA: "Tigers", ScoreA: 1, TeamB: "LIONS  FC", ScoreB: 1})
+	if err != nil {
+		t.Fatalf("Unexpected error playing game: %v", err)
+	}
+	if game.TeamB != "Lions" {
+		t.Errorf("Expected alias replaced by team name, got '%v'", game.TeamB)
+	}
+
+	stats, _ := tournament.GetAllStats()
+	if len(stats) != 2 || stats[0].Team != "Lions" || stats[0].Played != 2 {
+		t.Errorf("Expected Lions and Tigers with 2 games each, got %v", stats)
+	}
+	lionsStats, err := tournament.GetStats("lions fc")
+	if err != nil || lionsStats.Team != "Lions" || lionsStats.Played != 2 {
+		t.Errorf("Expected stats of Lions found by alias, got %v, %v", lionsStats, err)
+	}
+}
+
+func TestRenameTeam(t *testing.T) {
+	registry := NewTeamRegistry(&TeamsArray{})
+	lions, _ := registry.CreateTeam(Team{Name: "Loins", Aliases: []string{"Lions"}})
+
+	renamed, err := registry.As(Actor{Principal: "admin"}).RenameTeam(lions.ID, " Lions")
+	if err != nil {
+		t.Fatalf("Unexpected error renaming team: %v", err)
+	}
+	expected := &Team{ID: lions.ID, Name: "Lions", Aliases: []string{"Loins"}}
+	if !reflect.DeepEqual(renamed, expected) {
+		t.Errorf("Expected %v, got %v", expected, renamed)
+	}
+
+	if _, err := registry.RenameTeam(lions.ID, "LIONS"); err != nil {
+		t.Errorf("Unexpected error changing case of name: %v", err)
+	}
+
+	history, _ := registry.TeamHistory(lions.ID)
+	if len(history) != 2 || history[0].Action != RenameAction || history[0].From != "Loins" ||
+		history[0].To != "Lions" || history[0].Principal != "admin" {
+		t.Errorf("Expected renames recorded, got %v", history)
+	}
+}
+
+func TestMergeTeams(t *testing.T) {
+	registry := NewTeamRegistry(&TeamsArray{})
+	lions, _ := registry.CreateTeam(Team{Name: "Lions"})
+	typo, _ := registry.CreateTeam(Team{Name: "Loins", Aliases: []string{"Lions F.C."}})
+
+	if _, err := registry.MergeTeams(lions.ID, lions.ID); err != ErrMergeSameTeam {
+		t.Errorf("Expected ErrMergeSameTeam, got %v", err)
+	}
+
+	merged, err := registry.As(Actor{Principal: "admin"}).MergeTeams(typo.ID, lions.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error merging teams: %v", err)
+	}
+	expected := &Team{ID: lions.ID, Name: "Lions", Aliases: []string{"Loins", "Lions F.C."}}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Errorf("Expected %v, got %v", expected, merged)
+	}
+	if _, err := registry.GetTeam(typo.ID); err != ErrTeamNotFound {
+		t.Errorf("Expected merged team removed, got %v", err)
+	}
+
+	history, _ := registry.TeamHistory(lions.ID)
+	if len(history) != 1 || history[0].Action != MergeAction || history[0].From != "Loins" || history[0].Principal != "admin" {
+		t.Errorf("Expected merge recorded, got %v", history)
+	}
+}
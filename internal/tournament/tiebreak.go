@@ -0,0 +1,233 @@
+package tournament
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+)
+
+// TieBreaker splits teams that are level on all previous criteria into
+// groups ordered from the highest ranked. Teams the criterion cannot separate
+// stay in the same group.
+type TieBreaker func(tied []Stats, games []Game, rules ScoringRules) [][]Stats
+
+var (
+	ByPoints         TieBreaker = byDescending(func(s Stats) int { return s.Points })
+	ByGoalDifference TieBreaker = byDescending(func(s Stats) int { return s.GoalDifference })
+	ByGoalsFor       TieBreaker = byDescending(func(s Stats) int { return s.GoalsFor })
+	ByWins           TieBreaker = byDescending(func(s Stats) int { return s.Won })
+	// ByRegulationWins ranks teams by their wins in regulation time.
+	ByRegulationWins TieBreaker = byDescending(func(s Stats) int { return s.RegulationWon })
+	// ByPlayedGoalDifference and ByPlayedGoalsFor ignore the awarded scores.
+	ByPlayedGoalDifference TieBreaker = byDescending(func(s Stats) int {
+		return s.GoalDifference - s.AwardedGoalsFor + s.AwardedGoalsAgainst
+	})
+	ByPlayedGoalsFor TieBreaker = byDescending(func(s Stats) int { return s.GoalsFor - s.AwardedGoalsFor })
+
+	// ByHeadToHeadPoints ranks teams by the points earned in the games
+	// played between the tied teams only.
+	ByHeadToHeadPoints TieBreaker = byHeadToHead(func(s Stats) int { return s.Points })
+
+	// ByHeadToHeadGoalDifference ranks teams by the goal difference in the
+	// games played between the tied teams only.
+	ByHeadToHeadGoalDifference TieBreaker = byHeadToHead(func(s Stats) int { return s.GoalDifference })
+)
+
+// ByHeadToHead ranks teams using a mini-league built only from the games
+// played between the tied teams, by points, goal difference and goals scored.
+// Teams that are still level while others got separated are ranked again
+// using a mini-league of their own games.
+func ByHeadToHead(tied []Stats, games []Game, rules ScoringRules) [][]Stats {
+	miniTable := headToHeadStats(tied, games, rules)
+	groups := splitByValues(tied,
+		func(s Stats) int { return miniTable[s.Team].Points },
+		func(s Stats) int { return miniTable[s.Team].GoalDifference },
+		func(s Stats) int { return miniTable[s.Team].GoalsFor })
+	if len(groups) == 1 {
+		return groups
+	}
+
+	result := make([][]Stats, 0, len(tied))
+	for _, group := range groups {
+		if len(group) > 1 {
+			result = append(result, ByHeadToHead(group, games, rules)...)
+		} else {
+			result = append(result, group)
+		}
+	}
+	return result
+}
+
+// ByName ranks teams alphabetically.
+func ByName(tied []Stats, games []Game, rules ScoringRules) [][]Stats {
+	sorted := append([]Stats(nil), tied...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Team < sorted[j].Team
+	})
+	return split(sorted, func(a, b Stats) bool { return a.Team == b.Team })
+}
+
+// ByDrawingOfLots ranks teams in a random order that is fixed by the seed, so
+// the same standings are returned every time they are computed.
+func ByDrawingOfLots(seed int64) TieBreaker {
+	lot := func(team string) uint64 {
+		h := fnv.New64a()
+		fmt.Fprintf(h, "%d:%s", seed, team)
+		return h.Sum64()
+	}
+	return func(tied []Stats, games []Game, rules ScoringRules) [][]Stats {
+		sorted := append([]Stats(nil), tied...)
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return lot(sorted[i].Team) < lot(sorted[j].Team)
+		})
+		return split(sorted, func(a, b Stats) bool { return a.Team == b.Team })
+	}
+}
+
+// DefaultTieBreakers rank teams by points, goal difference, goals scored,
+// head-to-head mini-league, wins and finally name.
+var DefaultTieBreakers = []TieBreaker{
+	ByPoints,
+	ByGoalDifference,
+	ByGoalsFor,
+	ByHeadToHead,
+	ByWins,
+	ByName,
+}
+
+// ParseTieBreakers returns the tie-breakers named in the comma separated
+// spec, e.g. "points,goal-difference,name". The seed is used by "draw".
+func ParseTieBreakers(spec string, seed int64) ([]TieBreaker, error) {
+	tieBreakers := []TieBreaker{}
+	for _, name := range strings.Split(spec, ",") {
+		switch strings.TrimSpace(name) {
+		case "points":
+			tieBreakers = append(tieBreakers, ByPoints)
+		case "goal-difference":
+			tieBreakers = append(tieBreakers, ByGoalDifference)
+		case "goals-for":
+			tieBreakers = append(tieBreakers, ByGoalsFor)
+		case "played-goal-difference":
+			tieBreakers = append(tieBreakers, ByPlayedGoalDifference)
+		case "played-goals-for":
+			tieBreakers = append(tieBreakers, ByPlayedGoalsFor)
+		case "head-to-head":
+			tieBreakers = append(tieBreakers, ByHeadToHead)
+		case "head-to-head-points":
+			tieBreakers = append(tieBreakers, ByHeadToHeadPoints)
+		case "head-to-head-goal-difference":
+			tieBreakers = append(tieBreakers, ByHeadToHeadGoalDifference)
+		case "wins":
+			tieBreakers = append(tieBreakers, ByWins)
+		case "regulation-wins":
+			tieBreakers = append(tieBreakers, ByRegulationWins)
+		case "name":
+			tieBreakers = append(tieBreakers, ByName)
+		case "draw":
+			tieBreakers = append(tieBreakers, ByDrawingOfLots(seed))
+		default:
+			return nil, &UnknownNameError{Kind: "tie-breaker", Name: name}
+		}
+	}
+	return tieBreakers, nil
+}
+
+func rankStats(stats []Stats, games []Game, rules ScoringRules, tieBreakers []TieBreaker) []Stats {
+	if len(stats) <= 1 || len(tieBreakers) == 0 {
+		return stats
+	}
+
+	result := make([]Stats, 0, len(stats))
+	for _, group := range tieBreakers[0](stats, games, rules) {
+		result = append(result, rankStats(group, games, rules, tieBreakers[1:])...)
+	}
+	return result
+}
+
+func byDescending(value func(Stats) int) TieBreaker {
+	return func(tied []Stats, games []Game, rules ScoringRules) [][]Stats {
+		return splitByValues(tied, value)
+	}
+}
+
+func byHeadToHead(value func(Stats) int) TieBreaker {
+	return func(tied []Stats, games []Game, rules ScoringRules) [][]Stats {
+		miniTable := headToHeadStats(tied, games, rules)
+		return splitByValues(tied, func(s Stats) int { return value(miniTable[s.Team]) })
+	}
+}
+
+// headToHeadStats computes stats of the tied teams from the games they played
+// against each other.
+func headToHeadStats(tied []Stats, games []Game, rules ScoringRules) map[string]Stats {
+	stats := []*Stats{}
+	for _, game := range headToHeadGames(teamNames(tied), games) {
+		stats = updateStats(stats, &game, rules)
+	}
+
+	miniTable := make(map[string]Stats, len(tied))
+	for _, s := range tied {
+		miniTable[s.Team] = Stats{Team: s.Team}
+	}
+	for _, s := range stats {
+		miniTable[s.Team] = *s
+	}
+	return miniTable
+}
+
+// headToHeadGames returns the games played between the teams.
+func headToHeadGames(teams []string, games []Game) []Game {
+	isTeam := make(map[string]bool, len(teams))
+	for _, team := range teams {
+		isTeam[team] = true
+	}
+
+	result := []Game{}
+	for _, game := range games {
+		if isTeam[game.TeamA] && isTeam[game.TeamB] {
+			result = append(result, game)
+		}
+	}
+	return result
+}
+
+func teamNames(stats []Stats) []string {
+	names := make([]string, 0, len(stats))
+	for _, s := range stats {
+		names = append(names, s.Team)
+	}
+	return names
+}
+
+// splitByValues orders teams by the values, highest first, comparing the next
+// value only when the previous ones are equal.
+func splitByValues(tied []Stats, values ...func(Stats) int) [][]Stats {
+	compare := func(a, b Stats) int {
+		for _, value := range values {
+			if d := value(a) - value(b); d != 0 {
+				return d
+			}
+		}
+		return 0
+	}
+
+	sorted := append([]Stats(nil), tied...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return compare(sorted[i], sorted[j]) > 0
+	})
+	return split(sorted, func(a, b Stats) bool { return compare(a, b) == 0 })
+}
+
+// split cuts sorted stats into groups of consecutive teams that are equal.
+func split(sorted []Stats, equal func(a, b Stats) bool) [][]Stats {
+	groups := [][]Stats{}
+	for i, s := range sorted {
+		if i > 0 && equal(sorted[i-1], s) {
+			groups[len(groups)-1] = append(groups[len(groups)-1], s)
+		} else {
+			groups = append(groups, []Stats{s})
+		}
+	}
+	return groups
+}
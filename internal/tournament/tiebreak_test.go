@@ -0,0 +1,194 @@
+package tournament
+
+import (
+	"reflect"
+	"testing"
+)
+
+var tieBreakTestData = []struct {
+	testName    string
+	tieBreakers []TieBreaker
+	games       []Game
+	ranking     []string
+}{
+	{
+		testName:    "equal points ordered by name",
+		tieBreakers: DefaultTieBreakers,
+		games: []Game{
+			{TeamA: "c", ScoreA: 0, TeamB: "b", ScoreB: 0},
+			{TeamA: "b", ScoreA: 1, TeamB: "a", ScoreB: 1},
+		},
+		ranking: []string{"b", "a", "c"},
+	},
+	{
+		testName:    "three-way tie on points resolved by goal difference",
+		tieBreakers: DefaultTieBreakers,
+		games: []Game{
+			{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 0},
+			{TeamA: "b", ScoreA: 3, TeamB: "c", ScoreB: 0},
+			{TeamA: "c", ScoreA: 1, TeamB: "a", ScoreB: 0},
+		},
+		ranking: []string{"b", "a", "c"},
+	},
+	{
+		testName:    "goal difference tie resolved by goals scored",
+		tieBreakers: DefaultTieBreakers,
+		games: []Game{
+			{TeamA: "d", ScoreA: 0, TeamB: "b", ScoreB: 2},
+			{TeamA: "c", ScoreA: 1, TeamB: "a", ScoreB: 3},
+		},
+		ranking: []string{"a", "b", "c", "d"},
+	},
+	{
+		testName:    "goals tie resolved by head-to-head points",
+		tieBreakers: DefaultTieBreakers,
+		games: []Game{
+			{TeamA: "a", ScoreA: 0, TeamB: "b", ScoreB: 1},
+			{TeamA: "a", ScoreA: 1, TeamB: "c", ScoreB: 0},
+			{TeamA: "b", ScoreA: 0, TeamB: "c", ScoreB: 1},
+			{TeamA: "c", ScoreA: 0, TeamB: "d", ScoreB: 1},
+		},
+		ranking: []string{"d", "b", "a", "c"},
+	},
+	{
+		testName:    "three-way head-to-head tie resolved by head-to-head goal difference",
+		tieBreakers: DefaultTieBreakers,
+		games: []Game{
+			{TeamA: "c", ScoreA: 2, TeamB: "b", ScoreB: 0},
+			{TeamA: "b", ScoreA: 2, TeamB: "a", ScoreB: 0},
+			{TeamA: "a", ScoreA: 1, TeamB: "c", ScoreB: 0},
+			{TeamA: "c", ScoreA: 1, TeamB: "e", ScoreB: 0},
+			{TeamA: "c", ScoreA: 0, TeamB: "f", ScoreB: 2},
+			{TeamA: "b", ScoreA: 1, TeamB: "e", ScoreB: 0},
+			{TeamA: "b", ScoreA: 0, TeamB: "f", ScoreB: 1},
+			{TeamA: "a", ScoreA: 2, TeamB: "e", ScoreB: 0},
+			{TeamA: "a", ScoreA: 0, TeamB: "f", ScoreB: 1},
+		},
+		ranking: []string{"f", "c", "b", "a", "e"},
+	},
+	{
+		testName:    "head-to-head mini-league applied again to teams still level",
+		tieBreakers: DefaultTieBreakers,
+		games: []Game{
+			{TeamA: "a", ScoreA: 1, TeamB: "c", ScoreB: 0},
+			{TeamA: "a", ScoreA: 0, TeamB: "b", ScoreB: 0},
+			{TeamA: "a", ScoreA: 1, TeamB: "d", ScoreB: 0},
+			{TeamA: "c", ScoreA: 1, TeamB: "b", ScoreB: 0},
+			{TeamA: "c", ScoreA: 0, TeamB: "d", ScoreB: 0},
+			{TeamA: "b", ScoreA: 1, TeamB: "d", ScoreB: 0},
+			{TeamA: "a", ScoreA: 1, TeamB: "e", ScoreB: 3},
+			{TeamA: "b", ScoreA: 1, TeamB: "e", ScoreB: 0},
+			{TeamA: "b", ScoreA: 1, TeamB: "f", ScoreB: 2},
+			{TeamA: "c", ScoreA: 1, TeamB: "e", ScoreB: 0},
+			{TeamA: "c", ScoreA: 1, TeamB: "f", ScoreB: 2},
+			{TeamA: "d", ScoreA: 1, TeamB: "e", ScoreB: 0},
+			{TeamA: "d", ScoreA: 2, TeamB: "f", ScoreB: 1},
+		},
+		ranking: []string{"a", "c", "b", "d", "f", "e"},
+	},
+	{
+		testName:    "head-to-head tie resolved by wins",
+		tieBreakers: DefaultTieBreakers,
+		games: []Game{
+			{TeamA: "z", ScoreA: 1, TeamB: "x", ScoreB: 0},
+			{TeamA: "z", ScoreA: 0, TeamB: "y", ScoreB: 1},
+			{TeamA: "b", ScoreA: 1, TeamB: "x", ScoreB: 1},
+			{TeamA: "b", ScoreA: 0, TeamB: "y", ScoreB: 0},
+			{TeamA: "b", ScoreA: 0, TeamB: "w", ScoreB: 0},
+		},
+		ranking: []string{"y", "z", "b", "w", "x"},
+	},
+	{
+		testName:    "custom chain ignores goals",
+		tieBreakers: []TieBreaker{ByPoints, ByName},
+		games: []Game{
+			{TeamA: "c", ScoreA: 5, TeamB: "d", ScoreB: 0},
+			{TeamA: "b", ScoreA: 1, TeamB: "e", ScoreB: 0},
+		},
+		ranking: []string{"b", "c", "d", "e"},
+	},
+	{
+		testName:    "awarded scores ignored",
+		tieBreakers: []TieBreaker{ByPoints, ByPlayedGoalDifference, ByName},
+		games: []Game{
+			{TeamA: "a", ScoreA: 1, TeamB: "c", ScoreB: 0},
+			{TeamA: "b", ScoreA: 3, TeamB: "d", ScoreB: 0, Outcome: Forfeit},
+		},
+		ranking: []string{"a", "b", "d", "c"},
+	},
+}
+
+func TestTieBreakers(t *testing.T) {
+	for _, testData := range tieBreakTestData {
+		tournament := NewTournament(&GamesArray{}, WithTieBreakers(testData.tieBreakers...))
+		for _, game := range testData.games {
+			tournament.Play(game)
+		}
+
+		allStats, _ := tournament.GetAllStats()
+		ranking := teamNames(allStats)
+		if !reflect.DeepEqual(ranking, testData.ranking) {
+			t.Errorf("%v: expected ranking %v, got %v", testData.testName, testData.ranking, ranking)
+		}
+	}
+}
+
+func TestHeadToHead(t *testing.T) {
+	tournament := NewTournament(&GamesArray{})
+	tournament.Play(Game{TeamA: "a", ScoreA: 0, TeamB: "b", ScoreB: 1})
+	tournament.Play(Game{TeamA: "a", ScoreA: 5, TeamB: "c", ScoreB: 0})
+	tournament.Play(Game{TeamA: "b", ScoreA: 2, TeamB: "c", ScoreB: 2})
+	tournament.Play(Game{TeamA: "c", ScoreA: 1, TeamB: "d", ScoreB: 0})
+
+	stats, err := tournament.HeadToHead([]string{"c", "a", "b"})
+	if err != nil {
+		t.Fatalf("Unexpected error getting head-to-head stats: %v", err)
+	}
+	expectedStats := []Stats{
+		Stats{Team: "b", Played: 2, Won: 1, RegulationWon: 1, Drawn: 1, GoalsFor: 3, GoalsAgainst: 2, GoalDifference: 1, Points: 4},
+		Stats{Team: "a", Played: 2, Won: 1, RegulationWon: 1, Lost: 1, GoalsFor: 5, GoalsAgainst: 1, GoalDifference: 4, Points: 3},
+		Stats{Team: "c", Played: 2, Drawn: 1, Lost: 1, GoalsFor: 2, GoalsAgainst: 7, GoalDifference: -5, Points: 1},
+	}
+	if !reflect.DeepEqual(stats, expectedStats) {
+		t.Errorf("Head-to-head stats - expected: %v, got: %v", expectedStats, stats)
+	}
+
+	if _, err := tournament.HeadToHead([]string{"a", "unknown"}); err != ErrTeamNotFound {
+		t.Errorf("Expected ErrTeamNotFound for team that has not played yet, got %v", err)
+	}
+}
+
+func TestDrawingOfLotsIsRepeatable(t *testing.T) {
+	games := []Game{
+		{TeamA: "a", ScoreA: 0, TeamB: "b", ScoreB: 0},
+		{TeamA: "c", ScoreA: 0, TeamB: "d", ScoreB: 0},
+		{TeamA: "e", ScoreA: 0, TeamB: "f", ScoreB: 0},
+	}
+
+	first := NewTournament(&GamesArray{}, WithTieBreakers(ByPoints, ByDrawingOfLots(42)))
+	second := NewTournament(&GamesArray{}, WithTieBreakers(ByPoints, ByDrawingOfLots(42)))
+	for i := range games {
+		first.Play(games[i])
+		second.Play(games[len(games)-1-i])
+	}
+
+	firstStats, _ := first.GetAllStats()
+	secondStats, _ := second.GetAllStats()
+	if !reflect.DeepEqual(teamNames(firstStats), teamNames(secondStats)) {
+		t.Errorf("Expected the same ranking for the same seed, got %v and %v", teamNames(firstStats), teamNames(secondStats))
+	}
+}
+
+func TestParseTieBreakers(t *testing.T) {
+	tieBreakers, err := ParseTieBreakers("points, goal-difference,regulation-wins,draw", 1)
+	if err != nil {
+		t.Fatalf("Unexpected error parsing tie-breakers: %v", err)
+	}
+	if len(tieBreakers) != 4 {
+		t.Errorf("Expected 4 tie-breakers, got %v", len(tieBreakers))
+	}
+
+	if _, err := ParseTieBreakers("points,coin-toss", 1); err == nil {
+		t.Errorf("Expected error parsing unknown tie-breaker")
+	}
+}
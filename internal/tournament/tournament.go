@@ -2,52 +2,265 @@ package tournament
 
 import (
 	"errors"
-	"sort"
+	"fmt"
+	"time"
 )
 
 type Tournament struct {
-	games Games
+	games       Games
+	fixtures    Fixtures
+	brackets    Brackets
+	groupStages GroupStages
+	rules       ScoringRules
+	tieBreakers []TieBreaker
+	zones       Zones
+	auditLog    AuditLog
+	teams       []string
+	registry    Teams
+	adjustments PointAdjustments
+	publisher   Publisher
+	live        *LiveGames
+	actor       Actor
+	// final standings when the season is closed
+	archive []Stats
 }
 
 type Game struct {
+	ID     int
 	TeamA  string
 	ScoreA int
 	TeamB  string
 	ScoreB int
+	// DecidedIn tells in which period a game that needed a winner was
+	// settled. Penalty shoot-out scores are not part of ScoreA and ScoreB.
+	DecidedIn  Period
+	PenaltiesA int
+	PenaltiesB int
+	// Outcome tells whether the game was played or its result awarded. The
+	// awarded scores of a forfeit or walkover name the winner.
+	Outcome Outcome
+	// PlayedAt is when the game was played, the time it is recorded when not
+	// set. RecordedAt is set by the repository.
+	PlayedAt   time.Time
+	RecordedAt time.Time
+}
+
+// Period of the game in which the result was decided.
+type Period int
+
+const (
+	Regulation Period = iota
+	ExtraTime
+	Penalties
+)
+
+var periodNames = []string{"regulation", "extraTime", "penalties"}
+
+func (p Period) String() string {
+	if p < 0 || int(p) >= len(periodNames) {
+		return fmt.Sprintf("Period(%d)", int(p))
+	}
+	return periodNames[p]
+}
+
+// ParsePeriod returns the period with the given name.
+func ParsePeriod(name string) (Period, error) {
+	for i, n := range periodNames {
+		if n == name {
+			return Period(i), nil
+		}
+	}
+	return Regulation, &UnknownNameError{Kind: "period", Name: name}
+}
+
+// Outcome tells how the result of a game came about.
+type Outcome int
+
+const (
+	Played Outcome = iota
+	// Forfeit is awarded against the team with the lower score, e.g. for not
+	// showing up or fielding an ineligible player.
+	Forfeit
+	// Walkover is awarded to the team with the higher score when the other
+	// team could not play, without penalizing it.
+	Walkover
+	// DoubleForfeit is awarded against both teams, who both lose.
+	DoubleForfeit
+)
+
+var outcomeNames = []string{"played", "forfeit", "walkover", "doubleForfeit"}
+
+func (o Outcome) String() string {
+	if o < 0 || int(o) >= len(outcomeNames) {
+		return fmt.Sprintf("Outcome(%d)", int(o))
+	}
+	return outcomeNames[o]
+}
+
+// ParseOutcome returns the outcome with the given name.
+func ParseOutcome(name string) (Outcome, error) {
+	for i, n := range outcomeNames {
+		if n == name {
+			return Outcome(i), nil
+		}
+	}
+	return Played, &UnknownNameError{Kind: "outcome", Name: name}
+}
+
+// IsAwarded tells whether the result was awarded rather than played.
+func (g *Game) IsAwarded() bool {
+	return g.Outcome != Played
+}
+
+// Winner returns the team that won the game, including by penalty shoot-out,
+// or an empty string for a draw.
+func (g *Game) Winner() string {
+	if g.Outcome == DoubleForfeit {
+		return ""
+	}
+	scoreA, scoreB := g.ScoreA, g.ScoreB
+	if scoreA == scoreB && g.DecidedIn == Penalties {
+		scoreA, scoreB = g.PenaltiesA, g.PenaltiesB
+	}
+
+	switch {
+	case scoreA > scoreB:
+		return g.TeamA
+	case scoreA < scoreB:
+		return g.TeamB
+	default:
+		return ""
+	}
 }
 
 type Stats struct {
-	Team   string
-	Played int
-	Won    int
-	Drawn  int
-	Lost   int
-	Points int
+	Team           string
+	Played         int
+	Won            int
+	Drawn          int
+	Lost           int
+	GoalsFor       int
+	GoalsAgainst   int
+	GoalDifference int
+	// Points include Adjustment, the total of the point adjustments.
+	Points     int
+	Adjustment int
+	// RegulationWon counts the wins in regulation time. OvertimeWon and
+	// OvertimeLost count the games decided in extra time or by penalties.
+	RegulationWon int
+	OvertimeWon   int
+	OvertimeLost  int
+	// AwardedGoalsFor and AwardedGoalsAgainst are the part of GoalsFor and
+	// GoalsAgainst coming from awarded results.
+	AwardedGoalsFor     int
+	AwardedGoalsAgainst int
+	// Zone is only set in the ranked standings of GetAllStats
+	Zone Zone
+}
+
+// UnknownNameError tells that the name parsed is not one of the names known for
+// its kind of value.
+type UnknownNameError struct {
+	Kind string
+	Name string
+}
+
+func (e *UnknownNameError) Error() string {
+	return fmt.Sprintf("Unknown %s '%s'", e.Kind, e.Name)
 }
 
 var ErrTeamNotFound = errors.New("Team not found")
+var ErrGameNotFound = errors.New("Game not found")
+var ErrKnockoutGameDecided = errors.New("Game decided a knockout match")
 
 type Games interface {
 	Save(game *Game) error
 	FindByTeam(team string) ([]Game, error)
+	// FindTeam returns the name of the team which played, spelled like in its
+	// games, ignoring the case of name. It returns ErrTeamNotFound when the
+	// team did not play.
+	FindTeam(name string) (string, error)
 	FindAll() ([]Game, error)
+	FindByID(id int) (*Game, error)
+	Update(game *Game) error
+	Delete(id int) error
+	// Find returns the page of games selected by the filter.
+	Find(filter GameFilter) (*GamePage, error)
 }
 
-func NewTournament(games Games) *Tournament {
-	return &Tournament{
-		games: games,
+// Option configures optional Tournament settings.
+type Option func(*Tournament)
+
+// WithScoringRules sets the rules used to award points. DefaultScoringRules
+// are used when not set.
+func WithScoringRules(rules ScoringRules) Option {
+	return func(t *Tournament) {
+		t.rules = rules
 	}
 }
 
+// WithTieBreakers sets the criteria, applied in order, used to rank teams in
+// GetAllStats. DefaultTieBreakers are used when not set.
+func WithTieBreakers(tieBreakers ...TieBreaker) Option {
+	return func(t *Tournament) {
+		t.tieBreakers = tieBreakers
+	}
+}
+
+func NewTournament(games Games, opts ...Option) *Tournament {
+	t := &Tournament{
+		games:       games,
+		rules:       DefaultScoringRules,
+		tieBreakers: DefaultTieBreakers,
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// GetStats returns the stats of the team, named by its name or alias when the
+// team registry is set.
 func (t *Tournament) GetStats(team string) (Stats, error) {
+	if t.registry != nil {
+		registered, err := t.registry.FindByName(team)
+		if err != nil && err != ErrTeamNotFound {
+			return Stats{}, err
+		}
+		if registered != nil {
+			team = registered.Name
+		}
+	}
+
+	if t.archive != nil {
+		for _, s := range t.archive {
+			if s.Team == team {
+				return s, nil
+			}
+		}
+		return Stats{}, ErrTeamNotFound
+	}
+
+	// a team without games is still known when its points were adjusted
 	teamGames, err := t.games.FindByTeam(team)
-	if err != nil {
+	if err != nil && err != ErrTeamNotFound {
+		return Stats{}, err
+	}
+	played := err == nil
+	if teamGames, err = t.countedGames(teamGames); err != nil {
 		return Stats{}, err
 	}
 
 	stats := []*Stats{}
 	for _, game := range teamGames {
-		stats = updateStats(stats, &game)
+		stats = updateStats(stats, &game, t.rules)
+	}
+	if t.adjustments != nil {
+		adjustments, err := t.adjustments.FindByTeam(team)
+		if err != nil {
+			return Stats{}, err
+		}
+		stats = adjustStats(stats, adjustments)
 	}
 
 	for _, s := range stats {
@@ -56,37 +269,210 @@ func (t *Tournament) GetStats(team string) (Stats, error) {
 		}
 	}
 
+	if !played {
+		return Stats{}, ErrTeamNotFound
+	}
 	return Stats{}, nil
 }
 
+// GetAllStats returns the ranked standings. The standings of a closed season
+// are the ones frozen when it was closed.
 func (t *Tournament) GetAllStats() ([]Stats, error) {
-	allGames, err := t.games.FindAll()
+	if t.archive != nil {
+		result := append([]Stats{}, t.archive...)
+		markZones(result, t.zones)
+		return result, nil
+	}
+
+	allGames, err := t.allCountedGames()
 	if err != nil {
 		return nil, err
 	}
+	return t.standings(allGames)
+}
 
+// standings returns the ranked stats of the teams from the games and the
+// point adjustments.
+func (t *Tournament) standings(allGames []Game) ([]Stats, error) {
 	allStats := []*Stats{}
 	for _, game := range allGames {
-		allStats = updateStats(allStats, &game)
+		allStats = updateStats(allStats, &game, t.rules)
+	}
+	if t.adjustments != nil {
+		adjustments, err := t.adjustments.FindAll()
+		if err != nil {
+			return nil, err
+		}
+		allStats = adjustStats(allStats, adjustments)
 	}
-
-	sort.Slice(allStats, func(i, j int) bool {
-		return allStats[i].Points > allStats[j].Points || allStats[i].Points == allStats[i].Points && allStats[i].Team < allStats[i].Team
-	})
 
 	result := make([]Stats, 0, len(allStats))
 	for _, stats := range allStats {
 		result = append(result, *stats)
 	}
 
+	result = rankStats(result, allGames, t.rules, t.tieBreakers)
+	markZones(result, t.zones)
 	return result, nil
 }
 
-func (t *Tournament) Play(game Game) error {
-	return t.games.Save(&game)
+// HeadToHead returns the standings of a mini-league built only from the games
+// played between the given teams.
+func (t *Tournament) HeadToHead(teams []string) ([]Stats, error) {
+	allGames, err := t.allCountedGames()
+	if err != nil {
+		return nil, err
+	}
+
+	played := map[string]bool{}
+	for _, game := range allGames {
+		played[game.TeamA] = true
+		played[game.TeamB] = true
+	}
+
+	for _, team := range teams {
+		if !played[team] {
+			return nil, ErrTeamNotFound
+		}
+	}
+
+	return t.miniLeague(teams, allGames), nil
+}
+
+// miniLeague returns the ranked stats of the teams from the games played
+// between them.
+func (t *Tournament) miniLeague(teams []string, allGames []Game) []Stats {
+	stats := make([]Stats, 0, len(teams))
+	for _, team := range teams {
+		stats = append(stats, Stats{Team: team})
+	}
+
+	miniTable := headToHeadStats(stats, allGames, t.rules)
+	for i := range stats {
+		stats[i] = miniTable[stats[i].Team]
+	}
+
+	return rankStats(stats, headToHeadGames(teams, allGames), t.rules, t.tieBreakers)
+}
+
+// Play records the game. When the game is an open match of a knockout bracket
+// it must have a winner, who then advances to the next round. When the game
+// completes the groups of a group stage its knockout bracket is drawn.
+func (t *Tournament) Play(game Game) (*Game, error) {
+	return t.playWith(game, t.saveGame)
 }
 
-func updateStats(stats []*Stats, game *Game) []*Stats {
+// playWith plays the game storing it with save.
+func (t *Tournament) playWith(game Game, save func(game *Game) error) (*Game, error) {
+	if t.archive != nil {
+		return nil, ErrSeasonClosed
+	}
+	if err := t.validateGame(&game); err != nil {
+		return nil, err
+	}
+
+	bracket, match, err := t.findOpenBracketMatch(&game)
+	if err != nil {
+		return nil, err
+	}
+	if match != nil && game.Winner() == "" {
+		return nil, ErrUndecidedKnockoutGame
+	}
+
+	if err := save(&game); err != nil {
+		return nil, err
+	}
+
+	if match != nil {
+		bracket.advance(match, game.Winner())
+		if err := t.brackets.Update(bracket); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := t.drawCompletedGroupStages(); err != nil {
+		return nil, err
+	}
+	t.publish(GameCreated, Event{Game: &game})
+	return &game, nil
+}
+
+func (t *Tournament) GetGame(id int) (*Game, error) {
+	return t.games.FindByID(id)
+}
+
+// UpdateGame corrects the recorded game. The game of a decided knockout match
+// must keep its teams and winner, as the winner already advanced.
+func (t *Tournament) UpdateGame(game Game) (*Game, error) {
+	return t.updateGameWith(game, t.updateGame)
+}
+
+// updateGameWith corrects the recorded game storing it with update.
+func (t *Tournament) updateGameWith(game Game, update func(recorded, game *Game) error) (*Game, error) {
+	if t.archive != nil {
+		return nil, ErrSeasonClosed
+	}
+
+	recorded, err := t.games.FindByID(game.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.validateGame(&game); err != nil {
+		return nil, err
+	}
+	decided, err := t.decidedKnockoutGame(recorded)
+	if err != nil {
+		return nil, err
+	}
+	if decided && (!sameTeams(recorded, &game) || recorded.Winner() != game.Winner()) {
+		return nil, ErrKnockoutGameDecided
+	}
+
+	if err := update(recorded, &game); err != nil {
+		return nil, err
+	}
+	t.publish(GameUpdated, Event{Game: &game})
+	return &game, nil
+}
+
+// DeleteGame removes the recorded game, unless it decided a knockout match.
+func (t *Tournament) DeleteGame(id int) error {
+	if t.archive != nil {
+		return ErrSeasonClosed
+	}
+
+	recorded, err := t.games.FindByID(id)
+	if err != nil {
+		return err
+	}
+	decided, err := t.decidedKnockoutGame(recorded)
+	if err != nil {
+		return err
+	}
+	if decided {
+		return ErrKnockoutGameDecided
+	}
+
+	if err := t.deleteGame(recorded); err != nil {
+		return err
+	}
+	if err := t.unlinkFixture(id); err != nil {
+		return err
+	}
+	t.publish(GameDeleted, Event{Game: recorded})
+	return nil
+}
+
+// allCountedGames returns all games counting in the standings.
+func (t *Tournament) allCountedGames() ([]Game, error) {
+	allGames, err := t.games.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	return t.countedGames(allGames)
+}
+
+func updateStats(stats []*Stats, game *Game, rules ScoringRules) []*Stats {
 	var teamAStats, teamBStats *Stats
 
 	for _, s := range stats {
@@ -110,20 +496,49 @@ func updateStats(stats []*Stats, game *Game) []*Stats {
 	teamAStats.Played++
 	teamBStats.Played++
 
-	if game.ScoreA > game.ScoreB {
-		teamAStats.Won++
-		teamBStats.Lost++
-		teamAStats.Points += 3
-	} else if game.ScoreA < game.ScoreB {
+	teamAStats.GoalsFor += game.ScoreA
+	teamAStats.GoalsAgainst += game.ScoreB
+	teamAStats.GoalDifference = teamAStats.GoalsFor - teamAStats.GoalsAgainst
+	teamBStats.GoalsFor += game.ScoreB
+	teamBStats.GoalsAgainst += game.ScoreA
+	teamBStats.GoalDifference = teamBStats.GoalsFor - teamBStats.GoalsAgainst
+	if game.IsAwarded() {
+		teamAStats.AwardedGoalsFor += game.ScoreA
+		teamAStats.AwardedGoalsAgainst += game.ScoreB
+		teamBStats.AwardedGoalsFor += game.ScoreB
+		teamBStats.AwardedGoalsAgainst += game.ScoreA
+	}
+
+	winner := game.Winner()
+	decidedInOvertime := game.DecidedIn != Regulation && winner != ""
+	winnerStats, loserStats := teamAStats, teamBStats
+	if winner == game.TeamB {
+		winnerStats, loserStats = teamBStats, teamAStats
+	}
+	// the overtime columns are counted only with overtime scoring
+	if decidedInOvertime && rules.Overtime {
+		winnerStats.OvertimeWon++
+		loserStats.OvertimeLost++
+	}
+
+	switch {
+	case game.Outcome == DoubleForfeit:
 		teamAStats.Lost++
-		teamBStats.Won++
-		teamBStats.Points += 3
-	} else {
-		teamAStats.Points++
-		teamBStats.Points++
+		teamBStats.Lost++
+	case game.ScoreA != game.ScoreB || decidedInOvertime && rules.Overtime:
+		winnerStats.Won++
+		loserStats.Lost++
+		if !decidedInOvertime {
+			winnerStats.RegulationWon++
+		}
+	default:
 		teamAStats.Drawn++
 		teamBStats.Drawn++
 	}
 
+	pointsA, pointsB := rules.Points(game)
+	teamAStats.Points += pointsA
+	teamBStats.Points += pointsB
+
 	return stats
 }
@@ -2,17 +2,22 @@ package tournament
 
 import (
 	"reflect"
+	"sort"
+	"strings"
 	"testing"
 )
 
 type GamesArray struct {
-	games []Game
+	games  []Game
+	lastID int
 }
 
 func (ga *GamesArray) Save(game *Game) error {
 	if ga.games == nil {
 		ga.games = []Game{}
 	}
+	ga.lastID++
+	game.ID = ga.lastID
 	ga.games = append(ga.games, *game)
 	return nil
 }
@@ -30,10 +35,95 @@ func (ga *GamesArray) FindByTeam(team string) ([]Game, error) {
 	return teamGames, nil
 }
 
+func (ga *GamesArray) FindTeam(name string) (string, error) {
+	for _, game := range ga.games {
+		for _, team := range []string{game.TeamA, game.TeamB} {
+			if strings.EqualFold(team, name) {
+				return team, nil
+			}
+		}
+	}
+	return "", ErrTeamNotFound
+}
+
 func (ga *GamesArray) FindAll() ([]Game, error) {
 	return ga.games, nil
 }
 
+func (ga *GamesArray) FindByID(id int) (*Game, error) {
+	for _, game := range ga.games {
+		if game.ID == id {
+			return &game, nil
+		}
+	}
+	return nil, ErrGameNotFound
+}
+
+func (ga *GamesArray) Update(game *Game) error {
+	for i := range ga.games {
+		if ga.games[i].ID == game.ID {
+			ga.games[i] = *game
+			return nil
+		}
+	}
+	return ErrGameNotFound
+}
+
+func (ga *GamesArray) Find(filter GameFilter) (*GamePage, error) {
+	selected := []Game{}
+	for _, game := range ga.games {
+		if gameMatches(&game, &filter) {
+			selected = append(selected, game)
+		}
+	}
+	sort.SliceStable(selected, func(i, j int) bool {
+		return cursorBefore(CursorOf(&selected[i], filter.SortBy), CursorOf(&selected[j], filter.SortBy), filter.Descending)
+	})
+
+	page := &GamePage{Games: []Game{}}
+	for _, game := range selected {
+		if filter.After != nil && !cursorBefore(filter.After, CursorOf(&game, filter.SortBy), filter.Descending) {
+			continue
+		}
+		if len(page.Games) == filter.Limit {
+			page.NextCursor = CursorOf(&page.Games[len(page.Games)-1], filter.SortBy).String()
+			break
+		}
+		page.Games = append(page.Games, game)
+	}
+	return page, nil
+}
+
+func gameMatches(game *Game, filter *GameFilter) bool {
+	if filter.Team != "" && game.TeamA != filter.Team && game.TeamB != filter.Team {
+		return false
+	}
+	if filter.Opponent != "" && game.TeamA != filter.Opponent && game.TeamB != filter.Opponent {
+		return false
+	}
+	if !filter.From.IsZero() && game.PlayedAt.Before(filter.From) || !filter.To.IsZero() && !game.PlayedAt.Before(filter.To) {
+		return false
+	}
+	return filter.Result == AnyResult || game.ResultFor(filter.Team, filter.Scoring) == filter.Result
+}
+
+func cursorBefore(a, b *GameCursor, descending bool) bool {
+	if !a.Time.Equal(b.Time) {
+		return a.Time.Before(b.Time) != descending
+	}
+	return a.ID != b.ID && a.ID < b.ID != descending
+}
+
+func (ga *GamesArray) Delete(id int) error {
+	for i := range ga.games {
+		if ga.games[i].ID == id {
+			ga.games = append(ga.games[:i], ga.games[i+1:]...)
+			return nil
+		}
+	}
+	return ErrGameNotFound
+}
+
 var gameTestData = []struct {
 	testName string
 	games    []Game
@@ -42,34 +132,34 @@ var gameTestData = []struct {
 	{
 		testName: "single game",
 		games: []Game{
-			{"a", 2, "b", 1},
+			{TeamA: "a", ScoreA: 2, TeamB: "b", ScoreB: 1},
 		},
 		stats: []Stats{
-			Stats{Team: "a", Played: 1, Won: 1, Points: 3},
-			Stats{Team: "b", Played: 1, Lost: 1},
+			Stats{Team: "a", Played: 1, Won: 1, RegulationWon: 1, GoalsFor: 2, GoalsAgainst: 1, GoalDifference: 1, Points: 3},
+			Stats{Team: "b", Played: 1, Lost: 1, GoalsFor: 1, GoalsAgainst: 2, GoalDifference: -1},
 		},
 	},
 	{
 		testName: "drawn game",
 		games: []Game{
-			{"a", 1, "b", 1},
+			{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 1},
 		},
 		stats: []Stats{
-			Stats{Team: "a", Played: 1, Drawn: 1, Points: 1},
-			Stats{Team: "b", Played: 1, Drawn: 1, Points: 1},
+			Stats{Team: "a", Played: 1, Drawn: 1, GoalsFor: 1, GoalsAgainst: 1, Points: 1},
+			Stats{Team: "b", Played: 1, Drawn: 1, GoalsFor: 1, GoalsAgainst: 1, Points: 1},
 		},
 	},
 	{
 		testName: "multiple games",
 		games: []Game{
-			{"a", 1, "b", 0},
-			{"a", 3, "c", 3},
-			{"b", 0, "c", 1},
+			{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 0},
+			{TeamA: "a", ScoreA: 3, TeamB: "c", ScoreB: 3},
+			{TeamA: "b", ScoreA: 0, TeamB: "c", ScoreB: 1},
 		},
 		stats: []Stats{
-			Stats{Team: "a", Played: 2, Won: 1, Drawn: 1, Points: 4},
-			Stats{Team: "b", Played: 2, Lost: 2, Points: 0},
-			Stats{Team: "c", Played: 2, Won: 1, Drawn: 1, Points: 4},
+			Stats{Team: "a", Played: 2, Won: 1, RegulationWon: 1, Drawn: 1, GoalsFor: 4, GoalsAgainst: 3, GoalDifference: 1, Points: 4},
+			Stats{Team: "b", Played: 2, Lost: 2, GoalsFor: 0, GoalsAgainst: 2, GoalDifference: -2, Points: 0},
+			Stats{Team: "c", Played: 2, Won: 1, RegulationWon: 1, Drawn: 1, GoalsFor: 4, GoalsAgainst: 3, GoalDifference: 1, Points: 4},
 		},
 	},
 }
@@ -100,18 +190,55 @@ func TestGames(t *testing.T) {
 func TestGetAllStats(t *testing.T) {
 	tournament := NewTournament(&GamesArray{})
 
-	tournament.Play(Game{"a", 1, "b", 0})
-	tournament.Play(Game{"a", 3, "c", 3})
-	tournament.Play(Game{"b", 0, "c", 1})
+	tournament.Play(Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 0})
+	tournament.Play(Game{TeamA: "a", ScoreA: 3, TeamB: "c", ScoreB: 3})
+	tournament.Play(Game{TeamA: "b", ScoreA: 0, TeamB: "c", ScoreB: 1})
 
 	allStats, _ := tournament.GetAllStats()
 	expectedStats := []Stats{
-		Stats{Team: "a", Played: 2, Won: 1, Drawn: 1, Points: 4},
-		Stats{Team: "c", Played: 2, Won: 1, Drawn: 1, Points: 4},
-		Stats{Team: "b", Played: 2, Lost: 2, Points: 0},
+		Stats{Team: "a", Played: 2, Won: 1, RegulationWon: 1, Drawn: 1, GoalsFor: 4, GoalsAgainst: 3, GoalDifference: 1, Points: 4},
+		Stats{Team: "c", Played: 2, Won: 1, RegulationWon: 1, Drawn: 1, GoalsFor: 4, GoalsAgainst: 3, GoalDifference: 1, Points: 4},
+		Stats{Team: "b", Played: 2, Lost: 2, GoalsFor: 0, GoalsAgainst: 2, GoalDifference: -2, Points: 0},
 	}
 
 	if !reflect.DeepEqual(allStats, expectedStats) {
 		t.Errorf("All stats - expected: %v, got: %v", expectedStats, allStats)
 	}
 }
+
+func TestUpdateAndDeleteGame(t *testing.T) {
+	tournament := NewTournament(&GamesArray{})
+	game, err := tournament.Play(Game{TeamA: "a", ScoreA: 1, TeamB: "b", ScoreB: 0})
+	if err != nil {
+		t.Fatalf("Unexpected error playing game: %v", err)
+	}
+	tournament.Play(Game{TeamA: "a", ScoreA: 2, TeamB: "c", ScoreB: 2})
+
+	game.ScoreB = 3
+	if _, err := tournament.UpdateGame(*game); err != nil {
+		t.Fatalf("Unexpected error updating game: %v", err)
+	}
+	if got, _ := tournament.GetGame(game.ID); !reflect.DeepEqual(got, game) {
+		t.Errorf("Expected updated game %v, got %v", game, got)
+	}
+	if stats, _ := tournament.GetStats("b"); stats.Won != 1 {
+		t.Errorf("Expected corrected score to count as a win of b, got %v", stats)
+	}
+
+	if err := tournament.DeleteGame(game.ID); err != nil {
+		t.Fatalf("Unexpected error deleting game: %v", err)
+	}
+	if _, err := tournament.GetStats("b"); err != ErrTeamNotFound {
+		t.Errorf("Expected deleted game not to count, got %v", err)
+	}
+
+	if _, err := tournament.GetGame(game.ID); err != ErrGameNotFound {
+		t.Errorf("Expected ErrGameNotFound getting deleted game, got %v", err)
+	}
+	if _, err := tournament.UpdateGame(Game{ID: 42, TeamA: "a", TeamB: "b"}); err != ErrGameNotFound {
+		t.Errorf("Expected ErrGameNotFound updating unknown game, got %v", err)
+	}
+	if err := tournament.DeleteGame(42); err != ErrGameNotFound {
+		t.Errorf("Expected ErrGameNotFound deleting unknown game, got %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+package tournament
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxTeamNameLength is the longest team name that can be stored.
+const MaxTeamNameLength = 40
+
+// FieldError tells what is wrong with a field of a game.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// ValidationError lists every field error of a game.
+type ValidationError struct {
+	Errors []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, fe := range e.Errors {
+		messages = append(messages, fe.Field+" "+fe.Message)
+	}
+	return "Invalid game: " + strings.Join(messages, ", ")
+}
+
+func (e *ValidationError) add(field, format string, args ...interface{}) {
+	e.Errors = append(e.Errors, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// WithTeams restricts the games to the teams entered into the competition.
+func WithTeams(teams []string) Option {
+	return func(t *Tournament) {
+		t.teams = teams
+	}
+}
+
+// NormalizeTeamName trims the name and collapses the whitespace inside it.
+func NormalizeTeamName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// validateGame normalizes the team names of the game, replacing the aliases
+// of registered teams and spelling them like the known teams they differ from
+// in case only, and returns a ValidationError listing every invalid field.
+func (t *Tournament) validateGame(game *Game) error {
+	var err error
+	verr := &ValidationError{}
+	if game.TeamA, err = t.validateTeam(verr, "teamA", game.TeamA); err != nil {
+		return err
+	}
+	if game.TeamB, err = t.validateTeam(verr, "teamB", game.TeamB); err != nil {
+		return err
+	}
+	if game.TeamA != "" && strings.EqualFold(game.TeamA, game.TeamB) {
+		verr.add("teamB", "must differ from teamA")
+	}
+
+	for _, score := range []struct {
+		field string
+		value int
+	}{
+		{"scoreA", game.ScoreA},
+		{"scoreB", game.ScoreB},
+		{"penaltiesA", game.PenaltiesA},
+		{"penaltiesB", game.PenaltiesB},
+	} {
+		if score.value < 0 {
+			verr.add(score.field, "must not be negative")
+		}
+	}
+
+	switch {
+	case game.IsAwarded() && game.DecidedIn != Regulation:
+		verr.add("decidedIn", "must be regulation for an awarded result")
+	case (game.Outcome == Forfeit || game.Outcome == Walkover) && game.ScoreA == game.ScoreB:
+		verr.add("scoreB", "must differ from scoreA to name the winner of a %s", game.Outcome)
+	}
+
+	if len(verr.Errors) > 0 {
+		return verr
+	}
+	return nil
+}
+
+func (t *Tournament) validateTeam(verr *ValidationError, field, name string) (string, error) {
+	errorCount := len(verr.Errors)
+	name = validateTeamName(verr, field, name)
+	if len(verr.Errors) > errorCount {
+		return name, nil
+	}
+
+	if t.registry != nil {
+		team, err := t.registry.FindByName(name)
+		if err != nil && err != ErrTeamNotFound {
+			return name, err
+		}
+		if team != nil {
+			name = team.Name
+		}
+	}
+
+	if t.teams != nil {
+		for _, team := range t.teams {
+			if strings.EqualFold(team, name) {
+				return team, nil
+			}
+		}
+		verr.add(field, "is not a team of the competition")
+		return name, nil
+	}
+
+	played, err := t.games.FindTeam(name)
+	if err == ErrTeamNotFound {
+		return name, nil
+	}
+	if err != nil {
+		return name, err
+	}
+	return played, nil
+}
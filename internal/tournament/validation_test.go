@@ -0,0 +1,80 @@
+package tournament
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGameValidation(t *testing.T) {
+	tournament := NewTournament(&GamesArray{})
+
+	_, err := tournament.Play(Game{TeamA: " a ", ScoreA: -1, TeamB: "A", ScoreB: 0, PenaltiesB: -2})
+	verr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("Expected ValidationError, got %v", err)
+	}
+	expected := []FieldError{
+		{Field: "teamB", Message: "must differ from teamA"},
+		{Field: "scoreA", Message: "must not be negative"},
+		{Field: "penaltiesB", Message: "must not be negative"},
+	}
+	if !reflect.DeepEqual(verr.Errors, expected) {
+		t.Errorf("Expected field errors %v, got %v", expected, verr.Errors)
+	}
+
+	_, err = tournament.Play(Game{TeamA: "", TeamB: strings.Repeat("b", MaxTeamNameLength+1)})
+	expected = []FieldError{
+		{Field: "teamA", Message: "must not be empty"},
+		{Field: "teamB", Message: "must be at most 40 characters"},
+	}
+	if verr, ok := err.(*ValidationError); !ok || !reflect.DeepEqual(verr.Errors, expected) {
+		t.Errorf("Expected field errors %v, got %v", expected, err)
+	}
+}
+
+func TestTeamNamesNormalized(t *testing.T) {
+	tournament := NewTournament(&GamesArray{})
+	tournament.Play(Game{TeamA: "Real  Madrid", ScoreA: 1, TeamB: "Barcelona", ScoreB: 0})
+
+	game, err := tournament.Play(Game{TeamA: " barcelona", ScoreA: 2, TeamB: "real madrid", ScoreB: 2})
+	if err != nil {
+		t.Fatalf("Unexpected error playing game: %v", err)
+	}
+	if game.TeamA != "Barcelona" || game.TeamB != "Real Madrid" {
+		t.Errorf("Expected names spelled like known teams, got '%v' and '%v'", game.TeamA, game.TeamB)
+	}
+	if stats, _ := tournament.GetStats("Barcelona"); stats.Played != 2 {
+		t.Errorf("Expected 2 games of Barcelona, got %v", stats)
+	}
+}
+
+func TestGamesOfEnteredTeamsOnly(t *testing.T) {
+	tournament := NewTournament(&GamesArray{}, WithTeams([]string{"a", "b"}))
+
+	if _, err := tournament.Play(Game{TeamA: "A", ScoreA: 1, TeamB: "b", ScoreB: 0}); err != nil {
+		t.Errorf("Unexpected error playing game of entered teams: %v", err)
+	}
+
+	_, err := tournament.Play(Game{TeamA: "a", ScoreA: 1, TeamB: "c", ScoreB: 0})
+	expected := []FieldError{{Field: "teamB", Message: "is not a team of the competition"}}
+	if verr, ok := err.(*ValidationError); !ok || !reflect.DeepEqual(verr.Errors, expected) {
+		t.Errorf("Expected field errors %v, got %v", expected, err)
+	}
+}
+
+func TestAwardedResultValidation(t *testing.T) {
+	tournament := NewTournament(&GamesArray{})
+
+	_, err := tournament.Play(Game{TeamA: "a", ScoreA: 0, TeamB: "b", ScoreB: 0, Outcome: Walkover})
+	expected := []FieldError{{Field: "scoreB", Message: "must differ from scoreA to name the winner of a walkover"}}
+	if verr, ok := err.(*ValidationError); !ok || !reflect.DeepEqual(verr.Errors, expected) {
+		t.Errorf("Expected field errors %v, got %v", expected, err)
+	}
+
+	_, err = tournament.Play(Game{TeamA: "a", ScoreA: 3, TeamB: "b", ScoreB: 0, Outcome: Forfeit, DecidedIn: Penalties})
+	expected = []FieldError{{Field: "decidedIn", Message: "must be regulation for an awarded result"}}
+	if verr, ok := err.(*ValidationError); !ok || !reflect.DeepEqual(verr.Errors, expected) {
+		t.Errorf("Expected field errors %v, got %v", expected, err)
+	}
+}
@@ -0,0 +1,267 @@
+package tournament
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"time"
+)
+
+// DefaultDeliveryLimit is the number of deliveries listed when not set.
+const DefaultDeliveryLimit = 100
+
+var ErrWebhookNotFound = errors.New("Webhook not found")
+var ErrDeliveryNotFound = errors.New("Webhook delivery not found")
+var ErrDeliveryNotDead = errors.New("Only dead webhook deliveries can be retried")
+
+// WebhookEvents are the events webhooks can subscribe to.
+var WebhookEvents = []EventType{GameCreated, GameUpdated, GameDeleted, FixtureUpdated, PointsAdjusted, LeaderChanged}
+
+// Webhook is a subscription of another system to the events of all
+// competitions. Their payloads are signed with the secret.
+type Webhook struct {
+	ID        int
+	URL       string
+	Events    []EventType
+	Secret    string
+	Active    bool
+	CreatedAt time.Time
+}
+
+// Subscribes tells whether the webhook gets the events of the type.
+func (w *Webhook) Subscribes(eventType EventType) bool {
+	for _, e := range w.Events {
+		if e == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// OutboxEvent is an event stored in the same transaction as the change it
+// tells about, and delivered to the webhooks afterwards.
+type OutboxEvent struct {
+	ID            int64
+	CompetitionID int
+	Type          EventType
+	// Game is set for the game events, before the change when deleted
+	Game *Game
+	// Fixture is set for the FixtureUpdated events
+	Fixture *Fixture
+	// Adjustment is set for the PointsAdjusted events
+	Adjustment *PointAdjustment
+	// Leader is set for the LeaderChanged events
+	Leader *LeaderChange
+	At     time.Time
+}
+
+// LeaderChange tells which team tops the standings instead of which one.
+// Previous is empty for the first leader of a competition, and Team when the
+// standings have no teams left.
+type LeaderChange struct {
+	Team     string
+	Previous string
+}
+
+// DeliveryStatus is the state of the delivery of an event to a webhook.
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliveryDelivered DeliveryStatus = "delivered"
+	// DeliveryDead is given up after too many failed attempts
+	DeliveryDead DeliveryStatus = "dead"
+)
+
+// WebhookDelivery is the delivery of an event to a webhook, with the log of
+// its attempts.
+type WebhookDelivery struct {
+	ID            int
+	WebhookID     int
+	Event         OutboxEvent
+	Status        DeliveryStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	Log           []DeliveryAttempt
+	// URL and Secret of the webhook, set for the claimed deliveries only
+	URL    string
+	Secret string
+}
+
+// DeliveryAttempt records a request made to deliver an event. StatusCode is
+// not set when no response was received.
+type DeliveryAttempt struct {
+	Attempt    int
+	StatusCode int
+	Error      string
+	Duration   time.Duration
+	At         time.Time
+}
+
+// DeliveryFilter selects the deliveries of a webhook, the latest first.
+type DeliveryFilter struct {
+	// Status does not filter when empty
+	Status DeliveryStatus
+	Limit  int
+}
+
+type Webhooks interface {
+	Save(webhook *Webhook) error
+	Update(webhook *Webhook) error
+	Delete(id int) error
+	FindByID(id int) (*Webhook, error)
+	FindAll() ([]Webhook, error)
+	FindDeliveries(webhookID int, filter DeliveryFilter) ([]WebhookDelivery, error)
+	FindDelivery(id int) (*WebhookDelivery, error)
+	// Retry makes the delivery pending again, to be attempted at once.
+	Retry(deliveryID int) error
+}
+
+// Outbox hands the stored events over to the webhook deliveries.
+type Outbox interface {
+	// FanOut creates the deliveries of the events not handed over yet to the
+	// active webhooks subscribing to them, in a single transaction, and
+	// returns the events.
+	FanOut(limit int) ([]OutboxEvent, error)
+	// ChangeLeader stores the leader of the competition and, when it
+	// changed, the LeaderChanged event, in a single transaction. It returns
+	// the event, nil when the leader is the same.
+	ChangeLeader(competitionID int, team string) (*OutboxEvent, error)
+	// ClaimDeliveries returns the pending deliveries due for an attempt, and
+	// postpones their next attempt by the lease so that they are not claimed
+	// twice.
+	ClaimDeliveries(limit int, lease time.Duration) ([]WebhookDelivery, error)
+	// RecordAttempt stores the status and next attempt of the delivery along
+	// with the attempt.
+	RecordAttempt(delivery *WebhookDelivery, attempt *DeliveryAttempt) error
+}
+
+// WebhookRegistry manages the webhook subscriptions.
+type WebhookRegistry struct {
+	webhooks Webhooks
+}
+
+func NewWebhookRegistry(webhooks Webhooks) *WebhookRegistry {
+	return &WebhookRegistry{webhooks: webhooks}
+}
+
+// CreateWebhook subscribes the webhook to the events, generating its secret
+// when not set.
+func (r *WebhookRegistry) CreateWebhook(webhook Webhook) (*Webhook, error) {
+	webhook.ID = 0
+	if webhook.Secret == "" {
+		secret, err := generateSecret()
+		if err != nil {
+			return nil, err
+		}
+		webhook.Secret = secret
+	}
+	if err := validateWebhook(&webhook); err != nil {
+		return nil, err
+	}
+	if err := r.webhooks.Save(&webhook); err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+func (r *WebhookRegistry) GetWebhook(id int) (*Webhook, error) {
+	return r.webhooks.FindByID(id)
+}
+
+func (r *WebhookRegistry) GetWebhooks() ([]Webhook, error) {
+	return r.webhooks.FindAll()
+}
+
+// UpdateWebhook changes the webhook, keeping its secret when not set.
+func (r *WebhookRegistry) UpdateWebhook(webhook Webhook) (*Webhook, error) {
+	recorded, err := r.webhooks.FindByID(webhook.ID)
+	if err != nil {
+		return nil, err
+	}
+	if webhook.Secret == "" {
+		webhook.Secret = recorded.Secret
+	}
+	webhook.CreatedAt = recorded.CreatedAt
+	if err := validateWebhook(&webhook); err != nil {
+		return nil, err
+	}
+	if err := r.webhooks.Update(&webhook); err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// DeleteWebhook removes the webhook along with its deliveries.
+func (r *WebhookRegistry) DeleteWebhook(id int) error {
+	return r.webhooks.Delete(id)
+}
+
+// GetDeliveries returns the delivery log of the webhook, the latest first.
+// The dead deliveries make its dead-letter list.
+func (r *WebhookRegistry) GetDeliveries(webhookID int, filter DeliveryFilter) ([]WebhookDelivery, error) {
+	if _, err := r.webhooks.FindByID(webhookID); err != nil {
+		return nil, err
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultDeliveryLimit
+	}
+	return r.webhooks.FindDeliveries(webhookID, filter)
+}
+
+// RetryDelivery attempts the dead delivery again.
+func (r *WebhookRegistry) RetryDelivery(id int) (*WebhookDelivery, error) {
+	delivery, err := r.webhooks.FindDelivery(id)
+	if err != nil {
+		return nil, err
+	}
+	if delivery.Status != DeliveryDead {
+		return nil, ErrDeliveryNotDead
+	}
+	if err := r.webhooks.Retry(id); err != nil {
+		return nil, err
+	}
+	return r.webhooks.FindDelivery(id)
+}
+
+func validateWebhook(webhook *Webhook) error {
+	verr := &ValidationError{}
+	if u, err := url.Parse(webhook.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		verr.add("url", "must be an absolute http or https URL")
+	}
+
+	if len(webhook.Events) == 0 {
+		verr.add("events", "must not be empty")
+	}
+	seen := map[EventType]bool{}
+	events := make([]EventType, 0, len(webhook.Events))
+	for _, e := range webhook.Events {
+		known := false
+		for _, w := range WebhookEvents {
+			known = known || e == w
+		}
+		if !known {
+			verr.add("events", "must not include unknown event %q", e)
+		}
+		if !seen[e] {
+			seen[e] = true
+			events = append(events, e)
+		}
+	}
+	webhook.Events = events
+
+	if len(verr.Errors) > 0 {
+		return verr
+	}
+	return nil
+}
+
+func generateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
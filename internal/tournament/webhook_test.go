@@ -0,0 +1,149 @@
+package tournament
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type WebhooksArray struct {
+	webhooks   []Webhook
+	deliveries []WebhookDelivery
+}
+
+func (wa *WebhooksArray) Save(webhook *Webhook) error {
+	webhook.ID = len(wa.webhooks) + 1
+	webhook.CreatedAt = time.Now()
+	wa.webhooks = append(wa.webhooks, *webhook)
+	return nil
+}
+
+func (wa *WebhooksArray) Update(webhook *Webhook) error {
+	if _, err := wa.FindByID(webhook.ID); err != nil {
+		return err
+	}
+	wa.webhooks[webhook.ID-1] = *webhook
+	return nil
+}
+
+func (wa *WebhooksArray) Delete(id int) error {
+	if _, err := wa.FindByID(id); err != nil {
+		return err
+	}
+	wa.webhooks[id-1].ID = 0
+	return nil
+}
+
+func (wa *WebhooksArray) FindByID(id int) (*Webhook, error) {
+	if id < 1 || id > len(wa.webhooks) || wa.webhooks[id-1].ID == 0 {
+		return nil, ErrWebhookNotFound
+	}
+	webhook := wa.webhooks[id-1]
+	return &webhook, nil
+}
+
+func (wa *WebhooksArray) FindAll() ([]Webhook, error) {
+	webhooks := []Webhook{}
+	for _, webhook := range wa.webhooks {
+		if webhook.ID != 0 {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+	return webhooks, nil
+}
+
+func (wa *WebhooksArray) FindDeliveries(webhookID int, filter DeliveryFilter) ([]WebhookDelivery, error) {
+	deliveries := []WebhookDelivery{}
+	for i := len(wa.deliveries) - 1; i >= 0 && len(deliveries) < filter.Limit; i-- {
+		delivery := wa.deliveries[i]
+		if delivery.WebhookID == webhookID && (filter.Status == "" || delivery.Status == filter.Status) {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+	return deliveries, nil
+}
+
+func (wa *WebhooksArray) FindDelivery(id int) (*WebhookDelivery, error) {
+	if id < 1 || id > len(wa.deliveries) {
+		return nil, ErrDeliveryNotFound
+	}
+	delivery := wa.deliveries[id-1]
+	return &delivery, nil
+}
+
+func (wa *WebhooksArray) Retry(deliveryID int) error {
+	wa.deliveries[deliveryID-1].Status = DeliveryPending
+	wa.deliveries[deliveryID-1].NextAttemptAt = time.Now()
+	return nil
+}
+
+func TestCreateWebhook(t *testing.T) {
+	registry := NewWebhookRegistry(&WebhooksArray{})
+
+	webhook, err := registry.CreateWebhook(Webhook{URL: "https://cms.example.com/hooks", Events: []EventType{GameCreated, GameCreated, LeaderChanged}, Active: true})
+	if err != nil {
+		t.Fatalf("Unexpected error creating webhook: %v", err)
+	}
+	if webhook.ID != 1 || len(webhook.Secret) != 64 || !reflect.DeepEqual(webhook.Events, []EventType{GameCreated, LeaderChanged}) {
+		t.Errorf("Unexpected webhook: %v", webhook)
+	}
+	if !webhook.Subscribes(LeaderChanged) || webhook.Subscribes(GameUpdated) {
+		t.Errorf("Unexpected subscriptions of webhook: %v", webhook.Events)
+	}
+
+	updated, err := registry.UpdateWebhook(Webhook{ID: webhook.ID, URL: webhook.URL, Events: []EventType{GameUpdated}})
+	if err != nil {
+		t.Fatalf("Unexpected error updating webhook: %v", err)
+	}
+	if updated.Secret != webhook.Secret || updated.Active {
+		t.Errorf("Expected inactive webhook keeping its secret, got %v", updated)
+	}
+}
+
+func TestInvalidWebhook(t *testing.T) {
+	registry := NewWebhookRegistry(&WebhooksArray{})
+
+	_, err := registry.CreateWebhook(Webhook{URL: "ftp://cms.example.com", Events: []EventType{"game.played"}})
+	verr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("Expected validation error, got %v", err)
+	}
+	expected := []FieldError{
+		{Field: "url", Message: "must be an absolute http or https URL"},
+		{Field: "events", Message: `must not include unknown event "game.played"`},
+	}
+	if !reflect.DeepEqual(verr.Errors, expected) {
+		t.Errorf("Expected errors %v, got %v", expected, verr.Errors)
+	}
+
+	if _, err := registry.UpdateWebhook(Webhook{ID: 5, URL: "https://cms.example.com"}); err != ErrWebhookNotFound {
+		t.Errorf("Expected error %v, got %v", ErrWebhookNotFound, err)
+	}
+}
+
+func TestRetryDelivery(t *testing.T) {
+	webhooks := &WebhooksArray{
+		deliveries: []WebhookDelivery{
+			{ID: 1, WebhookID: 1, Status: DeliveryDelivered, Attempts: 1},
+			{ID: 2, WebhookID: 1, Status: DeliveryDead, Attempts: 5},
+		},
+	}
+	registry := NewWebhookRegistry(webhooks)
+	registry.CreateWebhook(Webhook{URL: "https://cms.example.com", Events: []EventType{GameCreated}})
+
+	dead, _ := registry.GetDeliveries(1, DeliveryFilter{Status: DeliveryDead})
+	if len(dead) != 1 || dead[0].ID != 2 {
+		t.Errorf("Expected dead delivery 2, got %v", dead)
+	}
+
+	if _, err := registry.RetryDelivery(1); err != ErrDeliveryNotDead {
+		t.Errorf("Expected error %v, got %v", ErrDeliveryNotDead, err)
+	}
+	retried, err := registry.RetryDelivery(2)
+	if err != nil {
+		t.Fatalf("Unexpected error retrying delivery: %v", err)
+	}
+	if retried.Status != DeliveryPending {
+		t.Errorf("Expected pending delivery, got %v", retried)
+	}
+}
@@ -0,0 +1,112 @@
+package tournament
+
+import (
+	"errors"
+)
+
+// Zone of the standings a team finishes in.
+type Zone string
+
+const (
+	NoZone                Zone = ""
+	PromotionZone         Zone = "promotion"
+	PromotionPlayoffZone  Zone = "promotion-playoff"
+	RelegationPlayoffZone Zone = "relegation-playoff"
+	RelegationZone        Zone = "relegation"
+)
+
+var ErrInvalidZones = errors.New("Invalid promotion and relegation zones")
+var ErrNotInPlayoffZone = errors.New("Team did not finish in a playoff place")
+
+// Zones are the numbers of places, counted from the top or from the bottom of
+// the standings, by which teams leave their division.
+type Zones struct {
+	// Promotion places at the top
+	Promotion int
+	// PromotionPlayoff places right below the promotion places
+	PromotionPlayoff int
+	// RelegationPlayoff places right above the relegation places
+	RelegationPlayoff int
+	// Relegation places at the bottom
+	Relegation int
+}
+
+// Movements are the teams leaving their division, by zone.
+type Movements struct {
+	Promoted          []string
+	PromotionPlayoff  []string
+	RelegationPlayoff []string
+	Relegated         []string
+}
+
+// WithZones sets the promotion and relegation zones marked in GetAllStats.
+func WithZones(zones Zones) Option {
+	return func(t *Tournament) {
+		t.zones = zones
+	}
+}
+
+// IsValid tells whether none of the numbers of places is negative.
+func (z Zones) IsValid() bool {
+	return z.Promotion >= 0 && z.PromotionPlayoff >= 0 && z.RelegationPlayoff >= 0 && z.Relegation >= 0
+}
+
+// IsZero tells whether no zone is defined.
+func (z Zones) IsZero() bool {
+	return z == Zones{}
+}
+
+// Of returns the zone of the place, counted from 1, in standings of the given
+// number of teams. Places at the top take precedence when zones overlap.
+func (z Zones) Of(place, teams int) Zone {
+	fromBottom := teams - place + 1
+	switch {
+	case place <= z.Promotion:
+		return PromotionZone
+	case place <= z.Promotion+z.PromotionPlayoff:
+		return PromotionPlayoffZone
+	case fromBottom <= z.Relegation:
+		return RelegationZone
+	case fromBottom <= z.Relegation+z.RelegationPlayoff:
+		return RelegationPlayoffZone
+	default:
+		return NoZone
+	}
+}
+
+// Movements returns the teams in the promotion and relegation zones of the
+// competition standings, the final ones once the season is closed.
+func (o *Organizer) Movements(competitionID int) (*Movements, error) {
+	t, err := o.Tournament(competitionID)
+	if err != nil {
+		return nil, err
+	}
+	standings, err := t.GetAllStats()
+	if err != nil {
+		return nil, err
+	}
+	return movements(teamNames(standings), t.zones), nil
+}
+
+func movements(teams []string, zones Zones) *Movements {
+	result := &Movements{}
+	for i, team := range teams {
+		switch zones.Of(i+1, len(teams)) {
+		case PromotionZone:
+			result.Promoted = append(result.Promoted, team)
+		case PromotionPlayoffZone:
+			result.PromotionPlayoff = append(result.PromotionPlayoff, team)
+		case RelegationPlayoffZone:
+			result.RelegationPlayoff = append(result.RelegationPlayoff, team)
+		case RelegationZone:
+			result.Relegated = append(result.Relegated, team)
+		}
+	}
+	return result
+}
+
+func markZones(standings []Stats, zones Zones) {
+	for i := range standings {
+		standings[i].Zone = zones.Of(i+1, len(standings))
+	}
+}
@@ -0,0 +1,96 @@
+package tournament
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestZoneOf(t *testing.T) {
+	zones := Zones{Promotion: 2, PromotionPlayoff: 1, RelegationPlayoff: 1, Relegation: 2}
+	expected := []Zone{PromotionZone, PromotionZone, PromotionPlayoffZone, NoZone, RelegationPlayoffZone, RelegationZone, RelegationZone}
+	for i, zone := range expected {
+		if got := zones.Of(i+1, len(expected)); got != zone {
+			t.Errorf("Expected place %v in zone %q, got %q", i+1, zone, got)
+		}
+	}
+
+	if got := (Zones{Promotion: 2, Relegation: 2}).Of(2, 3); got != PromotionZone {
+		t.Errorf("Expected top zone to take precedence, got %q", got)
+	}
+}
+
+func TestStatsMarkedWithZones(t *testing.T) {
+	tournament := NewTournament(&GamesArray{}, WithZones(Zones{Promotion: 1, Relegation: 1}))
+	tournament.Play(Game{TeamA: "a", ScoreA: 2, TeamB: "b", ScoreB: 0})
+	tournament.Play(Game{TeamA: "b", ScoreA: 1, TeamB: "c", ScoreB: 0})
+
+	allStats, _ := tournament.GetAllStats()
+	zones := []Zone{}
+	for _, s := range allStats {
+		zones = append(zones, s.Zone)
+	}
+	if !reflect.DeepEqual(zones, []Zone{PromotionZone, NoZone, RelegationZone}) {
+		t.Errorf("Expected zones [promotion  relegation], got %v", zones)
+	}
+}
+
+func TestMovements(t *testing.T) {
+	organizer := newTestOrganizer()
+	league, _ := organizer.CreateCompetition(Competition{Name: "league", Format: LeagueFormat, Scoring: DefaultScoringRules,
+		Zones: Zones{Promotion: 1, PromotionPlayoff: 1, Relegation: 1}})
+
+	leagueTournament, _ := organizer.Tournament(league.ID)
+	leagueTournament.Play(Game{TeamA: "a", ScoreA: 3, TeamB: "b", ScoreB: 0})
+	leagueTournament.Play(Game{TeamA: "c", ScoreA: 2, TeamB: "d", ScoreB: 0})
+
+	movements, err := organizer.Movements(league.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error getting movements: %v", err)
+	}
+	expected := &Movements{Promoted: []string{"a"}, PromotionPlayoff: []string{"c"}, Relegated: []string{"b"}}
+	if !reflect.DeepEqual(movements, expected) {
+		t.Errorf("Expected movements %v, got %v", expected, movements)
+	}
+
+	if _, err := organizer.CreateCompetition(Competition{Name: "cup", Format: LeagueFormat, Zones: Zones{Relegation: -1}}); err != ErrInvalidZones {
+		t.Errorf("Expected ErrInvalidZones, got %v", err)
+	}
+}
+
+func TestRolloverSeasonWithZones(t *testing.T) {
+	organizer := newTestOrganizer()
+	first, _ := organizer.CreateCompetition(Competition{Name: "first", Format: LeagueFormat, Scoring: DefaultScoringRules,
+		Zones: Zones{Promotion: 1, RelegationPlayoff: 1, Relegation: 1}})
+	second, _ := organizer.CreateCompetition(Competition{Name: "second", Format: LeagueFormat, Scoring: DefaultScoringRules,
+		Zones: Zones{Promotion: 1, PromotionPlayoff: 1}})
+
+	firstTournament, _ := organizer.Tournament(first.ID)
+	firstTournament.Play(Game{TeamA: "a", ScoreA: 3, TeamB: "b", ScoreB: 0})
+	firstTournament.Play(Game{TeamA: "c", ScoreA: 2, TeamB: "d", ScoreB: 0})
+	secondTournament, _ := organizer.Tournament(second.ID)
+	secondTournament.Play(Game{TeamA: "e", ScoreA: 3, TeamB: "f", ScoreB: 0})
+	secondTournament.Play(Game{TeamA: "g", ScoreA: 2, TeamB: "h", ScoreB: 0})
+	organizer.CloseSeason(first.ID)
+	organizer.CloseSeason(second.ID)
+
+	rollover := Rollover{Season: "2027", Divisions: []int{first.ID, second.ID}, PlayoffMovers: []string{"a"}}
+	if _, err := organizer.RolloverSeason(rollover); err != ErrNotInPlayoffZone {
+		t.Errorf("Expected ErrNotInPlayoffZone, got %v", err)
+	}
+
+	// first: a c d b, second: e g h f
+	rollover.PlayoffMovers = []string{"d", "g"}
+	next, err := organizer.RolloverSeason(rollover)
+	if err != nil {
+		t.Fatalf("Unexpected error rolling over season: %v", err)
+	}
+	if !reflect.DeepEqual(next[0].Teams, []string{"a", "c", "e", "g"}) {
+		t.Errorf("Expected first division teams [a c e g], got %v", next[0].Teams)
+	}
+	if !reflect.DeepEqual(next[1].Teams, []string{"b", "d", "h", "f"}) {
+		t.Errorf("Expected second division teams [b d h f], got %v", next[1].Teams)
+	}
+	if next[0].Zones != first.Zones {
+		t.Errorf("Expected zones %v to be kept, got %v", first.Zones, next[0].Zones)
+	}
+}
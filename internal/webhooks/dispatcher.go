@@ -0,0 +1,290 @@
+// Package webhooks delivers the events of the outbox to the webhooks
+// subscribing to them.
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/slawekzachcial/tournament/internal/tournament"
+)
+
+const (
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+	SignatureHeader = "X-Webhook-Signature"
+)
+
+// Dispatcher hands the outbox events over to the webhook deliveries and
+// attempts them, retrying the failed ones with exponential backoff until
+// they are given up as dead.
+type Dispatcher struct {
+	outbox tournament.Outbox
+	// leader returns the team at the top of the standings of the competition
+	leader func(competitionID int) (string, error)
+	client *http.Client
+
+	// MaxAttempts is the number of attempts before a delivery is dead
+	MaxAttempts int
+	// BaseDelay is the delay after the first failed attempt, doubled after
+	// every other one up to MaxDelay
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// BatchSize is the number of events and deliveries handled at once
+	BatchSize int
+	// Lease is how long a claimed delivery waits for its attempt before it
+	// can be claimed again
+	Lease time.Duration
+}
+
+func NewDispatcher(outbox tournament.Outbox, leader func(competitionID int) (string, error)) *Dispatcher {
+	return &Dispatcher{
+		outbox:      outbox,
+		leader:      leader,
+		client:      &http.Client{Timeout: 10 * time.Second},
+		MaxAttempts: 8,
+		BaseDelay:   30 * time.Second,
+		MaxDelay:    6 * time.Hour,
+		BatchSize:   100,
+		Lease:       time.Minute,
+	}
+}
+
+// Run dispatches the events every interval until the context is done.
+func (d *Dispatcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := d.Dispatch(ctx); err != nil {
+			log.Printf("Error dispatching webhook events: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch hands the new events over to the deliveries, adding the
+// LeaderChanged events of the competitions whose standings changed, and
+// attempts the deliveries due.
+func (d *Dispatcher) Dispatch(ctx context.Context) error {
+	events, err := d.outbox.FanOut(d.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	changed := map[int]bool{}
+	for _, event := range events {
+		if event.Type != tournament.LeaderChanged && !changed[event.CompetitionID] {
+			changed[event.CompetitionID] = true
+			leader, err := d.leader(event.CompetitionID)
+			if err != nil {
+				return err
+			}
+			if _, err := d.outbox.ChangeLeader(event.CompetitionID, leader); err != nil {
+				return err
+			}
+		}
+	}
+	if len(changed) > 0 {
+		if _, err := d.outbox.FanOut(d.BatchSize); err != nil {
+			return err
+		}
+	}
+
+	deliveries, err := d.outbox.ClaimDeliveries(d.BatchSize, d.Lease)
+	if err != nil {
+		return err
+	}
+	for i := range deliveries {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := d.deliver(ctx, &deliveries[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deliver attempts the delivery and records the attempt.
+func (d *Dispatcher) deliver(ctx context.Context, delivery *tournament.WebhookDelivery) error {
+	attempt := &tournament.DeliveryAttempt{Attempt: delivery.Attempts + 1, At: time.Now()}
+	statusCode, err := d.post(ctx, delivery)
+	attempt.Duration = time.Since(attempt.At)
+	attempt.StatusCode = statusCode
+	if err != nil {
+		attempt.Error = err.Error()
+	}
+
+	delivery.Attempts = attempt.Attempt
+	switch {
+	case err == nil:
+		delivery.Status = tournament.DeliveryDelivered
+	case delivery.Attempts >= d.MaxAttempts:
+		delivery.Status = tournament.DeliveryDead
+	default:
+		delivery.Status = tournament.DeliveryPending
+		delivery.NextAttemptAt = time.Now().Add(d.Backoff(delivery.Attempts))
+	}
+	return d.outbox.RecordAttempt(delivery, attempt)
+}
+
+// post sends the signed payload of the event and returns the status code of
+// the response, failing unless it is 2xx.
+func (d *Dispatcher) post(ctx context.Context, delivery *tournament.WebhookDelivery) (int, error) {
+	body, err := json.Marshal(NewPayload(&delivery.Event))
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequest(http.MethodPost, delivery.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(delivery.Event.Type))
+	req.Header.Set(DeliveryHeader, strconv.Itoa(delivery.ID))
+	req.Header.Set(SignatureHeader, Sign(delivery.Secret, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("Unexpected response status %v", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// Backoff returns the delay before the attempt following the failed one.
+func (d *Dispatcher) Backoff(attempt int) time.Duration {
+	delay := d.BaseDelay
+	for i := 1; i < attempt && delay < d.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > d.MaxDelay {
+		return d.MaxDelay
+	}
+	return delay
+}
+
+// Sign returns the signature of the payload, the hex encoded HMAC-SHA256 of
+// the body with the secret of the webhook prefixed with "sha256=".
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Payload is the body of the requests made to the webhooks.
+type Payload struct {
+	ID            int64     `json:"id"`
+	Type          string    `json:"type"`
+	CompetitionID int       `json:"competitionId"`
+	OccurredAt    time.Time `json:"occurredAt"`
+	Data          Data      `json:"data"`
+}
+
+type Data struct {
+	Game           *Game       `json:"game,omitempty"`
+	Fixture        *Fixture    `json:"fixture,omitempty"`
+	Adjustment     *Adjustment `json:"adjustment,omitempty"`
+	Leader         string      `json:"leader,omitempty"`
+	PreviousLeader string      `json:"previousLeader,omitempty"`
+}
+
+type Game struct {
+	ID         int       `json:"id"`
+	TeamA      string    `json:"teamA"`
+	ScoreA     int       `json:"scoreA"`
+	TeamB      string    `json:"teamB"`
+	ScoreB     int       `json:"scoreB"`
+	DecidedIn  string    `json:"decidedIn"`
+	PenaltiesA int       `json:"penaltiesA"`
+	PenaltiesB int       `json:"penaltiesB"`
+	Outcome    string    `json:"outcome"`
+	PlayedAt   time.Time `json:"playedAt"`
+	RecordedAt time.Time `json:"recordedAt"`
+}
+
+type Fixture struct {
+	ID       int    `json:"id"`
+	Round    int    `json:"round"`
+	HomeTeam string `json:"homeTeam"`
+	AwayTeam string `json:"awayTeam"`
+	Status   string `json:"status"`
+	GameID   int    `json:"gameId,omitempty"`
+}
+
+type Adjustment struct {
+	ID     int       `json:"id"`
+	Team   string    `json:"team"`
+	Delta  int       `json:"delta"`
+	Reason string    `json:"reason"`
+	At     time.Time `json:"at"`
+}
+
+func NewPayload(event *tournament.OutboxEvent) *Payload {
+	payload := &Payload{
+		ID:            event.ID,
+		Type:          string(event.Type),
+		CompetitionID: event.CompetitionID,
+		OccurredAt:    event.At,
+	}
+	if g := event.Game; g != nil {
+		payload.Data.Game = &Game{
+			ID:         g.ID,
+			TeamA:      g.TeamA,
+			ScoreA:     g.ScoreA,
+			TeamB:      g.TeamB,
+			ScoreB:     g.ScoreB,
+			DecidedIn:  g.DecidedIn.String(),
+			PenaltiesA: g.PenaltiesA,
+			PenaltiesB: g.PenaltiesB,
+			Outcome:    g.Outcome.String(),
+			PlayedAt:   g.PlayedAt,
+			RecordedAt: g.RecordedAt,
+		}
+	}
+	if f := event.Fixture; f != nil {
+		payload.Data.Fixture = &Fixture{
+			ID:       f.ID,
+			Round:    f.Round,
+			HomeTeam: f.HomeTeam,
+			AwayTeam: f.AwayTeam,
+			Status:   f.Status.String(),
+			GameID:   f.GameID,
+		}
+	}
+	if a := event.Adjustment; a != nil {
+		payload.Data.Adjustment = &Adjustment{
+			ID:     a.ID,
+			Team:   a.Team,
+			Delta:  a.Delta,
+			Reason: a.Reason,
+			At:     a.At,
+		}
+	}
+	if event.Leader != nil {
+		payload.Data.Leader = event.Leader.Team
+		payload.Data.PreviousLeader = event.Leader.Previous
+	}
+	return payload
+}
@@ -0,0 +1,219 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/slawekzachcial/tournament/internal/tournament"
+)
+
+type OutboxArray struct {
+	events     []tournament.OutboxEvent
+	fannedOut  int
+	webhook    tournament.Webhook
+	leaders    map[int]string
+	deliveries []tournament.WebhookDelivery
+	attempts   []tournament.DeliveryAttempt
+}
+
+func (oa *OutboxArray) FanOut(limit int) ([]tournament.OutboxEvent, error) {
+	events := oa.events[oa.fannedOut:]
+	for _, event := range events {
+		if oa.webhook.Active && oa.webhook.Subscribes(event.Type) {
+			oa.deliveries = append(oa.deliveries, tournament.WebhookDelivery{
+				ID:        len(oa.deliveries) + 1,
+				WebhookID: oa.webhook.ID,
+				Event:     event,
+				Status:    tournament.DeliveryPending,
+			})
+		}
+	}
+	oa.fannedOut = len(oa.events)
+	return events, nil
+}
+
+func (oa *OutboxArray) ChangeLeader(competitionID int, team string) (*tournament.OutboxEvent, error) {
+	previous := oa.leaders[competitionID]
+	if previous == team {
+		return nil, nil
+	}
+	oa.leaders[competitionID] = team
+	event := tournament.OutboxEvent{
+		ID:            int64(len(oa.events) + 1),
+		CompetitionID: competitionID,
+		Type:          tournament.LeaderChanged,
+		Leader:        &tournament.LeaderChange{Team: team, Previous: previous},
+		At:            time.Now(),
+	}
+	oa.events = append(oa.events, event)
+	return &event, nil
+}
+
+func (oa *OutboxArray) ClaimDeliveries(limit int, lease time.Duration) ([]tournament.WebhookDelivery, error) {
+	now := time.Now()
+	deliveries := []tournament.WebhookDelivery{}
+	for i := range oa.deliveries {
+		delivery := &oa.deliveries[i]
+		if delivery.Status == tournament.DeliveryPending && !delivery.NextAttemptAt.After(now) {
+			delivery.NextAttemptAt = now.Add(lease)
+			claimed := *delivery
+			claimed.URL, claimed.Secret = oa.webhook.URL, oa.webhook.Secret
+			deliveries = append(deliveries, claimed)
+		}
+	}
+	return deliveries, nil
+}
+
+func (oa *OutboxArray) RecordAttempt(delivery *tournament.WebhookDelivery, attempt *tournament.DeliveryAttempt) error {
+	recorded := &oa.deliveries[delivery.ID-1]
+	recorded.Status = delivery.Status
+	recorded.Attempts = delivery.Attempts
+	recorded.NextAttemptAt = delivery.NextAttemptAt
+	oa.attempts = append(oa.attempts, *attempt)
+	return nil
+}
+
+func (oa *OutboxArray) play(game tournament.Game) {
+	oa.events = append(oa.events, tournament.OutboxEvent{
+		ID:            int64(len(oa.events) + 1),
+		CompetitionID: tournament.DefaultCompetitionID,
+		Type:          tournament.GameCreated,
+		Game:          &game,
+		At:            time.Now(),
+	})
+}
+
+func TestDispatch(t *testing.T) {
+	type request struct {
+		event     string
+		signature string
+		payload   Payload
+	}
+	requests := []request{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		req := request{event: r.Header.Get(EventHeader), signature: r.Header.Get(SignatureHeader)}
+		if req.signature != Sign("secret", body) {
+			t.Errorf("Unexpected signature %v of payload %s", req.signature, body)
+		}
+		json.Unmarshal(body, &req.payload)
+		requests = append(requests, req)
+	}))
+	defer server.Close()
+
+	outbox := &OutboxArray{
+		webhook: tournament.Webhook{ID: 1, URL: server.URL, Secret: "secret", Active: true,
+			Events: []tournament.EventType{tournament.GameCreated, tournament.LeaderChanged}},
+		leaders: map[int]string{},
+	}
+	dispatcher := NewDispatcher(outbox, func(competitionID int) (string, error) {
+		return "a", nil
+	})
+
+	outbox.play(tournament.Game{TeamA: "a", ScoreA: 2, TeamB: "b", ScoreB: 1})
+	if err := dispatcher.Dispatch(context.Background()); err != nil {
+		t.Fatalf("Unexpected error dispatching: %v", err)
+	}
+	outbox.play(tournament.Game{TeamA: "a", ScoreA: 1, TeamB: "c", ScoreB: 0})
+	dispatcher.Dispatch(context.Background())
+
+	if len(requests) != 3 {
+		t.Fatalf("Expected 3 requests, got %v", requests)
+	}
+	first, second := requests[0], requests[1]
+	if first.event != "game.created" || first.payload.Data.Game == nil || first.payload.Data.Game.TeamB != "b" {
+		t.Errorf("Unexpected game request: %v", first)
+	}
+	if second.event != "standings.leader_changed" || second.payload.Data.Leader != "a" || second.payload.Data.PreviousLeader != "" {
+		t.Errorf("Unexpected leader request: %v", second)
+	}
+	for _, delivery := range outbox.deliveries {
+		if delivery.Status != tournament.DeliveryDelivered || delivery.Attempts != 1 {
+			t.Errorf("Expected delivery delivered at first attempt, got %v", delivery)
+		}
+	}
+}
+
+func TestDispatchAdjustment(t *testing.T) {
+	events := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload Payload
+		body, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(body, &payload)
+		if payload.Type == string(tournament.PointsAdjusted) && (payload.Data.Adjustment == nil || payload.Data.Adjustment.Delta != -3) {
+			t.Errorf("Unexpected adjustment payload %s", body)
+		}
+		events = append(events, r.Header.Get(EventHeader))
+	}))
+	defer server.Close()
+
+	outbox := &OutboxArray{
+		webhook: tournament.Webhook{ID: 1, URL: server.URL, Secret: "secret", Active: true,
+			Events: []tournament.EventType{tournament.PointsAdjusted, tournament.LeaderChanged}},
+		leaders: map[int]string{},
+	}
+	dispatcher := NewDispatcher(outbox, func(competitionID int) (string, error) {
+		return "b", nil
+	})
+
+	outbox.events = append(outbox.events, tournament.OutboxEvent{
+		ID:            1,
+		CompetitionID: tournament.DefaultCompetitionID,
+		Type:          tournament.PointsAdjusted,
+		Adjustment:    &tournament.PointAdjustment{ID: 1, Team: "a", Delta: -3, Reason: "Unpaid fine"},
+		At:            time.Now(),
+	})
+	if err := dispatcher.Dispatch(context.Background()); err != nil {
+		t.Fatalf("Unexpected error dispatching: %v", err)
+	}
+
+	if len(events) != 2 || events[0] != "points.adjusted" || events[1] != "standings.leader_changed" {
+		t.Errorf("Expected adjustment and leader change requests, got %v", events)
+	}
+}
+
+func TestDispatchRetries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	outbox := &OutboxArray{
+		webhook: tournament.Webhook{ID: 1, URL: server.URL, Secret: "secret", Active: true,
+			Events: []tournament.EventType{tournament.GameCreated}},
+		leaders: map[int]string{},
+	}
+	dispatcher := NewDispatcher(outbox, func(competitionID int) (string, error) {
+		return "a", nil
+	})
+	dispatcher.MaxAttempts = 3
+	dispatcher.BaseDelay = 0
+
+	outbox.play(tournament.Game{TeamA: "a", ScoreA: 2, TeamB: "b", ScoreB: 1})
+	for i := 0; i < 4; i++ {
+		dispatcher.Dispatch(context.Background())
+	}
+
+	delivery := outbox.deliveries[0]
+	if delivery.Status != tournament.DeliveryDead || delivery.Attempts != 3 {
+		t.Errorf("Expected dead delivery after 3 attempts, got %v", delivery)
+	}
+	if len(outbox.attempts) != 3 || outbox.attempts[2].StatusCode != http.StatusServiceUnavailable || outbox.attempts[2].Error == "" {
+		t.Errorf("Unexpected attempts: %v", outbox.attempts)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	dispatcher := &Dispatcher{BaseDelay: time.Second, MaxDelay: 10 * time.Second}
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second}
+	for i, delay := range expected {
+		if backoff := dispatcher.Backoff(i + 1); backoff != delay {
+			t.Errorf("Expected backoff %v after attempt %v, got %v", delay, i+1, backoff)
+		}
+	}
+}
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS fixtures (
+    id serial PRIMARY KEY,
+    round int NOT NULL,
+    home_team varchar(40) NOT NULL,
+    away_team varchar(40)
+);
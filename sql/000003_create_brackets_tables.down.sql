@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS bracket_matches;
+DROP TABLE IF EXISTS brackets;
+
+ALTER TABLE games
+    DROP COLUMN IF EXISTS decided_in,
+    DROP COLUMN IF EXISTS penalties_a,
+    DROP COLUMN IF EXISTS penalties_b;
@@ -0,0 +1,19 @@
+ALTER TABLE games
+    ADD COLUMN decided_in varchar(20) NOT NULL DEFAULT 'regulation',
+    ADD COLUMN penalties_a int NOT NULL DEFAULT 0,
+    ADD COLUMN penalties_b int NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS brackets (
+    id serial PRIMARY KEY,
+    name varchar(80) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS bracket_matches (
+    bracket_id int NOT NULL REFERENCES brackets(id) ON DELETE CASCADE,
+    round int NOT NULL,
+    position int NOT NULL,
+    team_a varchar(40),
+    team_b varchar(40),
+    winner varchar(40),
+    PRIMARY KEY (bracket_id, round, position)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS group_stage_teams;
+DROP TABLE IF EXISTS group_stages;
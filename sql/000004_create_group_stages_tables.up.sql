@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS group_stages (
+    id serial PRIMARY KEY,
+    name varchar(80) NOT NULL,
+    qualifiers int NOT NULL,
+    bracket_id int REFERENCES brackets(id)
+);
+
+CREATE TABLE IF NOT EXISTS group_stage_teams (
+    group_stage_id int NOT NULL REFERENCES group_stages(id) ON DELETE CASCADE,
+    group_index int NOT NULL,
+    group_name varchar(40) NOT NULL,
+    position int NOT NULL,
+    team varchar(40) NOT NULL,
+    PRIMARY KEY (group_stage_id, team)
+);
@@ -0,0 +1,6 @@
+ALTER TABLE group_stages DROP COLUMN IF EXISTS competition_id;
+ALTER TABLE brackets DROP COLUMN IF EXISTS competition_id;
+ALTER TABLE fixtures DROP COLUMN IF EXISTS competition_id;
+ALTER TABLE games DROP COLUMN IF EXISTS competition_id;
+
+DROP TABLE IF EXISTS competitions;
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS competitions (
+    id serial PRIMARY KEY,
+    name varchar(80) NOT NULL,
+    season varchar(20) NOT NULL DEFAULT '',
+    format varchar(20) NOT NULL,
+    win_points int NOT NULL,
+    draw_points int NOT NULL,
+    loss_points int NOT NULL,
+    big_win_margin int NOT NULL DEFAULT 0,
+    big_win_bonus int NOT NULL DEFAULT 0
+);
+
+-- games recorded so far belong to the default competition
+INSERT INTO competitions(id, name, format, win_points, draw_points, loss_points)
+    VALUES (1, 'Default', 'league', 3, 1, 0);
+SELECT setval('competitions_id_seq', (SELECT max(id) FROM competitions));
+
+ALTER TABLE games ADD COLUMN competition_id int NOT NULL DEFAULT 1 REFERENCES competitions(id);
+ALTER TABLE games ALTER COLUMN competition_id DROP DEFAULT;
+CREATE INDEX games_competition_id_idx ON games(competition_id);
+
+ALTER TABLE fixtures ADD COLUMN competition_id int NOT NULL DEFAULT 1 REFERENCES competitions(id);
+ALTER TABLE fixtures ALTER COLUMN competition_id DROP DEFAULT;
+
+ALTER TABLE brackets ADD COLUMN competition_id int NOT NULL DEFAULT 1 REFERENCES competitions(id);
+ALTER TABLE brackets ALTER COLUMN competition_id DROP DEFAULT;
+
+ALTER TABLE group_stages ADD COLUMN competition_id int NOT NULL DEFAULT 1 REFERENCES competitions(id);
+ALTER TABLE group_stages ALTER COLUMN competition_id DROP DEFAULT;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS standings_snapshots;
+DROP TABLE IF EXISTS competition_teams;
+
+ALTER TABLE competitions DROP COLUMN IF EXISTS closed;
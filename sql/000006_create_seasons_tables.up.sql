@@ -0,0 +1,24 @@
+ALTER TABLE competitions ADD COLUMN closed boolean NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS competition_teams (
+    competition_id int NOT NULL REFERENCES competitions(id) ON DELETE CASCADE,
+    position int NOT NULL,
+    team varchar(40) NOT NULL,
+    PRIMARY KEY (competition_id, team)
+);
+
+-- final standings of closed seasons
+CREATE TABLE IF NOT EXISTS standings_snapshots (
+    competition_id int NOT NULL REFERENCES competitions(id) ON DELETE CASCADE,
+    position int NOT NULL,
+    team varchar(40) NOT NULL,
+    played int NOT NULL,
+    won int NOT NULL,
+    drawn int NOT NULL,
+    lost int NOT NULL,
+    goals_for int NOT NULL,
+    goals_against int NOT NULL,
+    goal_difference int NOT NULL,
+    points int NOT NULL,
+    PRIMARY KEY (competition_id, position)
+);
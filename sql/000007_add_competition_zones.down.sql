@@ -0,0 +1,4 @@
+ALTER TABLE competitions DROP COLUMN IF EXISTS relegation_places;
+ALTER TABLE competitions DROP COLUMN IF EXISTS relegation_playoff_places;
+ALTER TABLE competitions DROP COLUMN IF EXISTS promotion_playoff_places;
+ALTER TABLE competitions DROP COLUMN IF EXISTS promotion_places;
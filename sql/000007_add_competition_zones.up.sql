@@ -0,0 +1,4 @@
+ALTER TABLE competitions ADD COLUMN promotion_places int NOT NULL DEFAULT 0;
+ALTER TABLE competitions ADD COLUMN promotion_playoff_places int NOT NULL DEFAULT 0;
+ALTER TABLE competitions ADD COLUMN relegation_playoff_places int NOT NULL DEFAULT 0;
+ALTER TABLE competitions ADD COLUMN relegation_places int NOT NULL DEFAULT 0;
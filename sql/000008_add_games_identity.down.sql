@@ -0,0 +1,3 @@
+ALTER TABLE games DROP COLUMN IF EXISTS recorded_at;
+ALTER TABLE games DROP COLUMN IF EXISTS played_at;
+ALTER TABLE games DROP COLUMN IF EXISTS id;
@@ -0,0 +1,3 @@
+ALTER TABLE games ADD COLUMN id serial PRIMARY KEY;
+ALTER TABLE games ADD COLUMN played_at timestamptz NOT NULL DEFAULT now();
+ALTER TABLE games ADD COLUMN recorded_at timestamptz NOT NULL DEFAULT now();
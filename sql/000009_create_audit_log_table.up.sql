@@ -0,0 +1,25 @@
+-- changes made to games, kept for deleted games too
+CREATE TABLE IF NOT EXISTS audit_log (
+    id serial PRIMARY KEY,
+    competition_id int NOT NULL,
+    game_id int NOT NULL,
+    action varchar(10) NOT NULL,
+    principal varchar(80) NOT NULL,
+    request_id varchar(80) NOT NULL,
+    at timestamptz NOT NULL DEFAULT now(),
+    before jsonb,
+    after jsonb
+);
+
+CREATE INDEX audit_log_game_id_idx ON audit_log(game_id);
+
+CREATE OR REPLACE FUNCTION audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_no_update_or_delete BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
+CREATE TRIGGER audit_log_no_truncate BEFORE TRUNCATE ON audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_log_append_only();
@@ -0,0 +1,13 @@
+ALTER TABLE games ADD COLUMN IF NOT EXISTS team_a varchar(40);
+ALTER TABLE games ADD COLUMN IF NOT EXISTS team_b varchar(40);
+UPDATE games SET
+    team_a = (SELECT name FROM teams WHERE id = team_a_id),
+    team_b = (SELECT name FROM teams WHERE id = team_b_id);
+ALTER TABLE games ALTER COLUMN team_a SET NOT NULL;
+ALTER TABLE games ALTER COLUMN team_b SET NOT NULL;
+
+ALTER TABLE games DROP COLUMN IF EXISTS team_a_id;
+ALTER TABLE games DROP COLUMN IF EXISTS team_b_id;
+
+DROP TABLE IF EXISTS team_aliases;
+DROP TABLE IF EXISTS teams;
@@ -0,0 +1,38 @@
+CREATE TABLE IF NOT EXISTS teams (
+    id serial PRIMARY KEY,
+    name varchar(40) NOT NULL,
+    short_code varchar(5)
+);
+
+CREATE UNIQUE INDEX teams_name_idx ON teams(lower(name));
+CREATE UNIQUE INDEX teams_short_code_idx ON teams(lower(short_code));
+
+CREATE TABLE IF NOT EXISTS team_aliases (
+    team_id int NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    alias varchar(40) NOT NULL
+);
+
+CREATE UNIQUE INDEX team_aliases_alias_idx ON team_aliases(lower(alias));
+
+-- teams named so far, trimmed and differing in case are one team
+INSERT INTO teams(name)
+    SELECT DISTINCT ON (lower(name)) name
+    FROM (
+        SELECT regexp_replace(trim(team_a), '\s+', ' ', 'g') AS name FROM games
+        UNION
+        SELECT regexp_replace(trim(team_b), '\s+', ' ', 'g') FROM games
+    ) AS named
+    ORDER BY lower(name), name;
+
+ALTER TABLE games ADD COLUMN team_a_id int REFERENCES teams(id);
+ALTER TABLE games ADD COLUMN team_b_id int REFERENCES teams(id);
+UPDATE games SET
+    team_a_id = (SELECT id FROM teams WHERE lower(name) = lower(regexp_replace(trim(team_a), '\s+', ' ', 'g'))),
+    team_b_id = (SELECT id FROM teams WHERE lower(name) = lower(regexp_replace(trim(team_b), '\s+', ' ', 'g')));
+ALTER TABLE games ALTER COLUMN team_a_id SET NOT NULL;
+ALTER TABLE games ALTER COLUMN team_b_id SET NOT NULL;
+CREATE INDEX games_team_a_id_idx ON games(team_a_id);
+CREATE INDEX games_team_b_id_idx ON games(team_b_id);
+
+ALTER TABLE games DROP COLUMN team_a;
+ALTER TABLE games DROP COLUMN team_b;
@@ -0,0 +1,13 @@
+-- renames of teams and merges of teams into them
+CREATE TABLE IF NOT EXISTS team_changes (
+    id serial PRIMARY KEY,
+    team_id int NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    action varchar(10) NOT NULL,
+    from_name varchar(40) NOT NULL,
+    to_name varchar(40) NOT NULL,
+    principal varchar(80) NOT NULL,
+    request_id varchar(80) NOT NULL,
+    at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX team_changes_team_id_idx ON team_changes(team_id);
@@ -0,0 +1,2 @@
+ALTER TABLE fixtures DROP COLUMN IF EXISTS game_id;
+ALTER TABLE fixtures DROP COLUMN IF EXISTS status;
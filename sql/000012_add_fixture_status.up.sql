@@ -0,0 +1,2 @@
+ALTER TABLE fixtures ADD COLUMN status varchar(20) NOT NULL DEFAULT 'scheduled';
+ALTER TABLE fixtures ADD COLUMN game_id int REFERENCES games(id) ON DELETE SET NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE competitions DROP COLUMN IF EXISTS forfeit_deduction;
+ALTER TABLE games DROP COLUMN IF EXISTS outcome;
@@ -0,0 +1,2 @@
+ALTER TABLE games ADD COLUMN outcome varchar(20) NOT NULL DEFAULT 'played';
+ALTER TABLE competitions ADD COLUMN forfeit_deduction int NOT NULL DEFAULT 0;
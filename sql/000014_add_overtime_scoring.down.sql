@@ -0,0 +1,3 @@
+ALTER TABLE competitions DROP COLUMN IF EXISTS overtime_loss_points;
+ALTER TABLE competitions DROP COLUMN IF EXISTS overtime_win_points;
+ALTER TABLE competitions DROP COLUMN IF EXISTS overtime;
@@ -0,0 +1,3 @@
+ALTER TABLE competitions ADD COLUMN overtime boolean NOT NULL DEFAULT false;
+ALTER TABLE competitions ADD COLUMN overtime_win_points int NOT NULL DEFAULT 0;
+ALTER TABLE competitions ADD COLUMN overtime_loss_points int NOT NULL DEFAULT 0;
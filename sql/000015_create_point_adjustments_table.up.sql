@@ -0,0 +1,13 @@
+-- points added to or deducted from teams, e.g. for disciplinary reasons
+CREATE TABLE IF NOT EXISTS point_adjustments (
+    id serial PRIMARY KEY,
+    competition_id int NOT NULL,
+    team varchar(40) NOT NULL,
+    delta int NOT NULL,
+    reason varchar(200) NOT NULL,
+    principal varchar(80) NOT NULL,
+    request_id varchar(80) NOT NULL,
+    at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX point_adjustments_competition_id_idx ON point_adjustments(competition_id);
@@ -0,0 +1,51 @@
+-- events stored in the same transaction as the changes they tell about, and
+-- handed over to the webhook deliveries once processed
+CREATE TABLE IF NOT EXISTS outbox (
+    id bigserial PRIMARY KEY,
+    competition_id int NOT NULL,
+    event_type varchar(40) NOT NULL,
+    payload jsonb NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    processed_at timestamptz
+);
+
+CREATE INDEX outbox_unprocessed_idx ON outbox(id) WHERE processed_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS webhooks (
+    id serial PRIMARY KEY,
+    url varchar(2048) NOT NULL,
+    events text[] NOT NULL,
+    secret varchar(200) NOT NULL,
+    active boolean NOT NULL DEFAULT true,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id serial PRIMARY KEY,
+    webhook_id int NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+    outbox_id bigint NOT NULL REFERENCES outbox(id),
+    status varchar(10) NOT NULL DEFAULT 'pending',
+    attempts int NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz NOT NULL DEFAULT now(),
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX webhook_deliveries_webhook_id_idx ON webhook_deliveries(webhook_id);
+CREATE INDEX webhook_deliveries_pending_idx ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+
+CREATE TABLE IF NOT EXISTS webhook_delivery_attempts (
+    delivery_id int NOT NULL REFERENCES webhook_deliveries(id) ON DELETE CASCADE,
+    attempt int NOT NULL,
+    status_code int,
+    error text,
+    duration_ms int NOT NULL,
+    at timestamptz NOT NULL,
+    PRIMARY KEY (delivery_id, attempt)
+);
+
+-- the team at the top of the standings of each competition, as last told to
+-- the webhooks
+CREATE TABLE IF NOT EXISTS standings_leaders (
+    competition_id int PRIMARY KEY,
+    team varchar(40) NOT NULL
+);
@@ -0,0 +1,3 @@
+-- the process which stored the event, so that it can tell its own changes
+-- from the changes of the other replicas
+ALTER TABLE outbox ADD COLUMN origin varchar(40) NOT NULL DEFAULT '';
@@ -0,0 +1,3 @@
+ALTER TABLE standings_snapshots DROP COLUMN IF EXISTS awarded_goals_against;
+ALTER TABLE standings_snapshots DROP COLUMN IF EXISTS awarded_goals_for;
+ALTER TABLE standings_snapshots DROP COLUMN IF EXISTS adjustment;
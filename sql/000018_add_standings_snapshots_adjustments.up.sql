@@ -0,0 +1,4 @@
+-- the final standings keep the point adjustments and the awarded goals
+ALTER TABLE standings_snapshots ADD COLUMN adjustment int NOT NULL DEFAULT 0;
+ALTER TABLE standings_snapshots ADD COLUMN awarded_goals_for int NOT NULL DEFAULT 0;
+ALTER TABLE standings_snapshots ADD COLUMN awarded_goals_against int NOT NULL DEFAULT 0;
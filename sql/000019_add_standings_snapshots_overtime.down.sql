@@ -0,0 +1,3 @@
+ALTER TABLE standings_snapshots DROP COLUMN IF EXISTS overtime_lost;
+ALTER TABLE standings_snapshots DROP COLUMN IF EXISTS overtime_won;
+ALTER TABLE standings_snapshots DROP COLUMN IF EXISTS regulation_won;
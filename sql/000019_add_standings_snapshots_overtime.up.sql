@@ -0,0 +1,5 @@
+-- the final standings keep the wins in regulation time and the games decided
+-- in extra time or by penalties
+ALTER TABLE standings_snapshots ADD COLUMN regulation_won int NOT NULL DEFAULT 0;
+ALTER TABLE standings_snapshots ADD COLUMN overtime_won int NOT NULL DEFAULT 0;
+ALTER TABLE standings_snapshots ADD COLUMN overtime_lost int NOT NULL DEFAULT 0;